@@ -20,6 +20,11 @@ func Values[T Valueser[T]]() []string {
 	return Slice(EnumValues[T]()...)
 }
 
+// ValuesExcept returns all known values of T other than the specified values.
+func ValuesExcept[T Valueser[T]](l ...T) []string {
+	return Slice(tfslices.RemoveAll(EnumValues[T](), l...)...)
+}
+
 func Slice[T Valueser[T]](l ...T) []string {
 	return tfslices.ApplyToAll(l, func(v T) string {
 		return string(v)
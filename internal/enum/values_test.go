@@ -26,3 +26,41 @@ func TestValues(t *testing.T) {
 		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestValuesExcept(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		except []types.AclPermission
+		want   []string
+	}{
+		"none": {
+			want: []string{"READ", "WRITE", "READ_ACP", "WRITE_ACP", "FULL_CONTROL"},
+		},
+		"one": {
+			except: []types.AclPermission{types.AclPermissionFullControl},
+			want:   []string{"READ", "WRITE", "READ_ACP", "WRITE_ACP"},
+		},
+		"several": {
+			except: []types.AclPermission{types.AclPermissionReadAcp, types.AclPermissionWriteAcp, types.AclPermissionFullControl},
+			want:   []string{"READ", "WRITE"},
+		},
+		"all": {
+			except: types.AclPermission("").Values(),
+			want:   []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ValuesExcept(testCase.except...)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
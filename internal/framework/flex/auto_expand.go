@@ -6,7 +6,9 @@ package flex
 import (
 	"context"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -40,7 +42,20 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	emptyStringAsNull bool
+}
+
+// WithEmptyStringAsNull is an Expand option that expands an empty String value into a nil *string
+// rather than a pointer to an empty string, e.g. for optional AWS API fields that reject empty values.
+// Non-pointer string fields, which are typically required, are still set to the empty string.
+func WithEmptyStringAsNull() AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		if expander, ok := flexer.(*autoExpander); ok {
+			expander.emptyStringAsNull = true
+		}
+	}
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -53,6 +68,10 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 	}
 
 	// No need to set the target value if there's no source value.
+	// A null (or unknown) primitive therefore leaves a nil pointer, whereas an explicit zero value
+	// (e.g. false or 0) is expanded to a pointer to that zero value so that AWS APIs can distinguish "not configured"
+	// from "configured to zero". Likewise a null (or unknown) collection leaves a nil slice or map, whereas an empty collection
+	// is expanded to an empty (non-nil) slice or map.
 	if vFrom.IsNull() || vFrom.IsUnknown() {
 		return diags
 	}
@@ -194,6 +213,11 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 		//
 		// types.Int32/types.Int64 -> int32/int64.
 		//
+		if vTo.OverflowInt(v.ValueInt64()) {
+			diags.Append(int64OverflowDiag(v.ValueInt64(), vTo.Type()))
+			return diags
+		}
+
 		vTo.SetInt(v.ValueInt64())
 		return diags
 
@@ -203,6 +227,11 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 			//
 			// types.Int32/types.Int64 -> *int32.
 			//
+			if v.ValueInt64() < math.MinInt32 || v.ValueInt64() > math.MaxInt32 {
+				diags.Append(int64OverflowDiag(v.ValueInt64(), vTo.Type().Elem()))
+				return diags
+			}
+
 			to := int32(v.ValueInt64())
 			vTo.Set(reflect.ValueOf(&to))
 			return diags
@@ -224,6 +253,28 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 	return diags
 }
 
+// int64OverflowDiag returns an error diagnostic for an integer value that does not fit in the target type.
+// The name of the field being converted is added by the caller.
+func int64OverflowDiag(v int64, t reflect.Type) diag.Diagnostic {
+	return diag.NewErrorDiagnostic("AutoFlEx", fmt.Sprintf("value (%d) overflows %s", v, t))
+}
+
+// expandSeconds returns the number of seconds represented by a String(ish) value being expanded into a seconds field,
+// e.g. an *InSeconds field. A fwtypes.Duration must be a whole number of seconds. Any other string is accepted only if it
+// is a bare integer, which keeps integer input working. It returns false if the value does not represent seconds.
+func expandSeconds(vFrom basetypes.StringValuable, v basetypes.StringValue) (int64, bool, diag.Diagnostics) {
+	if t, ok := vFrom.(fwtypes.Duration); ok {
+		seconds, diags := t.ValueSeconds()
+		return seconds, true, diags
+	}
+
+	if seconds, err := strconv.ParseInt(v.ValueString(), 10, 64); err == nil {
+		return seconds, true, nil
+	}
+
+	return 0, false, nil
+}
+
 // string copies a Plugin Framework String(ish) value to a compatible AWS API value.
 func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -241,6 +292,24 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		//
 		vTo.SetString(v.ValueString())
 		return diags
+	case reflect.Int32, reflect.Int64:
+		//
+		// fwtypes.Duration/types.String (bare integer) -> int32/int64 (seconds).
+		//
+		if seconds, ok, d := expandSeconds(vFrom, v); ok {
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if vTo.OverflowInt(seconds) {
+				diags.Append(int64OverflowDiag(seconds, vTo.Type()))
+				return diags
+			}
+
+			vTo.SetInt(seconds)
+			return diags
+		}
 	case reflect.Struct:
 		//
 		// fwtypes.Timestamp --> time.Time
@@ -255,8 +324,32 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			//
 			// types.String -> *string.
 			//
+			if expander.emptyStringAsNull && v.ValueString() == "" {
+				return diags
+			}
+
 			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
 			return diags
+		case reflect.Int32, reflect.Int64:
+			//
+			// fwtypes.Duration/types.String (bare integer) -> *int32/*int64 (seconds).
+			//
+			if seconds, ok, d := expandSeconds(vFrom, v); ok {
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				to := reflect.New(vTo.Type().Elem())
+				if to.Elem().OverflowInt(seconds) {
+					diags.Append(int64OverflowDiag(seconds, vTo.Type().Elem()))
+					return diags
+				}
+
+				to.Elem().SetInt(seconds)
+				vTo.Set(to)
+				return diags
+			}
 		case reflect.Struct:
 			//
 			// fwtypes.Timestamp --> *time.Time
@@ -316,6 +409,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 		case reflect.String:
 			//
 			// types.List(OfString) -> []string.
+			// types.List(OfStringEnum) -> []enum.
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -323,7 +417,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceOf(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -366,6 +460,9 @@ func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuab
 	case basetypes.StringTypable:
 		diags.Append(expander.mapOfString(ctx, v, vTo)...)
 		return diags
+	case basetypes.ListTypable:
+		diags.Append(expander.mapOfStringList(ctx, v, vTo)...)
+		return diags
 	case basetypes.ObjectTypable:
 		if vFrom, ok := vFrom.(fwtypes.ObjectMapValue); ok {
 			diags.Append(expander.objectMap(ctx, vFrom, vTo)...)
@@ -430,6 +527,42 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 	return diags
 }
 
+// mapOfStringList copies a Plugin Framework MapOfListOfString(ish) value to a compatible AWS API value.
+func (expander autoExpander) mapOfStringList(ctx context.Context, vFrom basetypes.MapValue, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch vTo.Kind() {
+	case reflect.Map:
+		switch tMapKey := vTo.Type().Key(); tMapKey.Kind() {
+		case reflect.String: // key
+			switch tMapElem := vTo.Type().Elem(); tMapElem.Kind() {
+			case reflect.Slice:
+				switch tMapElem.Elem().Kind() {
+				case reflect.String:
+					//
+					// types.Map(OfList(OfString)) -> map[string][]string.
+					//
+					var to map[string][]string
+					diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
+					if diags.HasError() {
+						return diags
+					}
+
+					vTo.Set(reflect.ValueOf(to))
+					return diags
+				}
+			}
+		}
+	}
+
+	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
+		"from map[string, %s]": vFrom.ElementType(ctx),
+		"to":                   vTo.Kind(),
+	})
+
+	return diags
+}
+
 // set copies a Plugin Framework Set(ish) value to a compatible AWS API value.
 func (expander autoExpander) set(ctx context.Context, vFrom basetypes.SetValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -470,6 +603,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 		case reflect.String:
 			//
 			// types.Set(OfString) -> []string.
+			// types.Set(OfStringEnum) -> []enum.
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -477,7 +611,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceOf(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -577,6 +711,11 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwt
 		return diags
 	}
 
+	// An empty list or set has no nested Object, so leave the target nil (or zero).
+	if v := reflect.ValueOf(from); v.Kind() == reflect.Ptr && v.IsNil() {
+		return diags
+	}
+
 	// Create a new target structure and walk its fields.
 	to := reflect.New(tStruct)
 	diags.Append(autoFlexConvertStruct(ctx, from, to.Interface(), expander)...)
@@ -595,6 +734,7 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwt
 }
 
 // nestedObjectToSlice copies a Plugin Framework NestedObjectValue to a compatible AWS API [](*)struct value.
+// An empty NestedObjectValue is copied as an empty (non-nil) slice.
 func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwtypes.NestedObjectValue, tSlice, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -790,3 +930,17 @@ func blockKeyMap(from any) (reflect.Value, diag.Diagnostics) {
 
 	return reflect.Zero(reflect.TypeOf("")), diags
 }
+
+// stringSliceOf returns the strings as a slice of the specified type, whose elements are string(ish).
+func stringSliceOf(from []string, tSlice reflect.Type) reflect.Value {
+	if from == nil {
+		return reflect.Zero(tSlice)
+	}
+
+	to := reflect.MakeSlice(tSlice, len(from), len(from))
+	for i, v := range from {
+		to.Index(i).SetString(v)
+	}
+
+	return to
+}
@@ -5,6 +5,8 @@ package flex
 
 import (
 	"context"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +29,8 @@ func TestExpand(t *testing.T) {
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
 
+	testJSON := `{"Key1":"Value","Key2":[1,2,3]}`
+
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
 
@@ -102,6 +106,12 @@ func TestExpand(t *testing.T) {
 			Target:     &TestFlexAWS02{},
 			WantTarget: &TestFlexAWS02{Field1: aws.String("a")},
 		},
+		{
+			TestName:   "single string Source and single enum Target",
+			Source:     &TestFlexTF01{Field1: types.StringValue("List")},
+			Target:     &TestFlexAWS22{},
+			WantTarget: &TestFlexAWS22{Field1: TestEnumList},
+		},
 		{
 			TestName:   "single string Source and single int64 Target",
 			Source:     &TestFlexTF01{Field1: types.StringValue("a")},
@@ -140,6 +150,70 @@ func TestExpand(t *testing.T) {
 				Field12: aws.Bool(false),
 			},
 		},
+		{
+			TestName: "null primitive types Source and primitive types Target",
+			Source: &TestFlexTF03{
+				Field1:  types.StringNull(),
+				Field2:  types.StringNull(),
+				Field3:  types.Int64Null(),
+				Field4:  types.Int64Null(),
+				Field5:  types.Int64Null(),
+				Field6:  types.Int64Null(),
+				Field7:  types.Float64Null(),
+				Field8:  types.Float64Null(),
+				Field9:  types.Float64Null(),
+				Field10: types.Float64Null(),
+				Field11: types.BoolNull(),
+				Field12: types.BoolNull(),
+			},
+			Target:     &TestFlexAWS04{},
+			WantTarget: &TestFlexAWS04{},
+		},
+		{
+			TestName: "unknown primitive types Source and primitive types Target",
+			Source: &TestFlexTF03{
+				Field1:  types.StringUnknown(),
+				Field2:  types.StringUnknown(),
+				Field3:  types.Int64Unknown(),
+				Field4:  types.Int64Unknown(),
+				Field5:  types.Int64Unknown(),
+				Field6:  types.Int64Unknown(),
+				Field7:  types.Float64Unknown(),
+				Field8:  types.Float64Unknown(),
+				Field9:  types.Float64Unknown(),
+				Field10: types.Float64Unknown(),
+				Field11: types.BoolUnknown(),
+				Field12: types.BoolUnknown(),
+			},
+			Target:     &TestFlexAWS04{},
+			WantTarget: &TestFlexAWS04{},
+		},
+		{
+			TestName: "zero-value primitive types Source and primitive types Target",
+			Source: &TestFlexTF03{
+				Field1:  types.StringValue(""),
+				Field2:  types.StringValue(""),
+				Field3:  types.Int64Value(0),
+				Field4:  types.Int64Value(0),
+				Field5:  types.Int64Value(0),
+				Field6:  types.Int64Value(0),
+				Field7:  types.Float64Value(0),
+				Field8:  types.Float64Value(0),
+				Field9:  types.Float64Value(0),
+				Field10: types.Float64Value(0),
+				Field11: types.BoolValue(false),
+				Field12: types.BoolValue(false),
+			},
+			Target: &TestFlexAWS04{},
+			WantTarget: &TestFlexAWS04{
+				Field2:  aws.String(""),
+				Field4:  aws.Int32(0),
+				Field6:  aws.Int64(0),
+				Field8:  aws.Float32(0),
+				Field10: aws.Float64(0),
+				Field12: aws.Bool(false),
+			},
+		},
 		{
 			TestName: "List/Set/Map of primitive types Source and slice/map of primtive types Target",
 			Source: &TestFlexTF04{
@@ -275,6 +349,203 @@ func TestExpand(t *testing.T) {
 			Target:     &TestFlexAWS02{},
 			WantTarget: &TestFlexAWS02{Field1: aws.String(testARN)},
 		},
+		{
+			TestName:   "single JSONString Source and single *string Target",
+			Source:     &TestFlexTF19{Field1: fwtypes.JSONStringValue(testJSON)},
+			Target:     &TestFlexAWS02{},
+			WantTarget: &TestFlexAWS02{Field1: aws.String(testJSON)},
+		},
+		{
+			TestName:   "single IAMPolicy Source and single *string Target",
+			Source:     &TestFlexTF25{Field1: fwtypes.IAMPolicyValue(testJSON)},
+			Target:     &TestFlexAWS02{},
+			WantTarget: &TestFlexAWS02{Field1: aws.String(testJSON)},
+		},
+		{
+			TestName:   "Int64 Source and int32 Target",
+			Source:     &TestFlexTF02{Field1: types.Int64Value(math.MaxInt32)},
+			Target:     &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{Field1: math.MaxInt32},
+		},
+		{
+			TestName: "overflowing Int64 Source and int32 Target",
+			Source:   &TestFlexTF02{Field1: types.Int64Value(math.MaxInt32 + 1)},
+			Target:   &TestFlexAWS19{},
+			WantErr:  true,
+		},
+		{
+			TestName: "overflowing Int64 Source and *int32 Target",
+			Source:   &TestFlexTF02{Field1: types.Int64Value(math.MinInt32 - 1)},
+			Target:   &TestFlexAWS20{},
+			WantErr:  true,
+		},
+		{
+			TestName: "Duration Source and seconds Target",
+			Source: &TestFlexTF22{
+				Field1: fwtypes.DurationValue("300s"),
+				Field2: fwtypes.DurationValue("5m"),
+				Field3: fwtypes.DurationValue("0"),
+			},
+			Target: &TestFlexAWS25{},
+			WantTarget: &TestFlexAWS25{
+				Field1: 300,
+				Field2: aws.Int32(300),
+				Field3: aws.Int64(0),
+			},
+		},
+		{
+			TestName: "bare seconds String Source and seconds Target",
+			Source: &TestFlexTF26{
+				Field1: types.StringValue("60"),
+				Field2: types.StringValue("60"),
+				Field3: types.StringValue("60"),
+			},
+			Target: &TestFlexAWS25{},
+			WantTarget: &TestFlexAWS25{
+				Field1: 60,
+				Field2: aws.Int32(60),
+				Field3: aws.Int64(60),
+			},
+		},
+		{
+			TestName: "fractional seconds Duration Source and seconds Target",
+			Source: &TestFlexTF22{
+				Field1: fwtypes.DurationValue("1.5s"),
+			},
+			Target:  &TestFlexAWS25{},
+			WantErr: true,
+		},
+		{
+			TestName: "null Duration Source and seconds Target",
+			Source: &TestFlexTF22{
+				Field1: fwtypes.DurationNull(),
+				Field2: fwtypes.DurationNull(),
+				Field3: fwtypes.DurationNull(),
+			},
+			Target:     &TestFlexAWS25{},
+			WantTarget: &TestFlexAWS25{},
+		},
+		{
+			TestName: "overflowing Duration Source and *int32 Target",
+			Source: &TestFlexTF22{
+				Field2: fwtypes.DurationValue("1000000h"),
+			},
+			Target:  &TestFlexAWS25{},
+			WantErr: true,
+		},
+		{
+			TestName: "primitive types Source and mixed value and pointer types Target",
+			Source: &TestFlexTF23{
+				Field1: types.BoolValue(true),
+				Field2: types.BoolValue(true),
+				Field3: types.StringValue("a"),
+				Field4: fwtypes.StringEnumValue(TestEnumList),
+			},
+			Target: &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{
+				Field1: true,
+				Field2: aws.Bool(true),
+				Field3: "a",
+				Field4: TestEnumList,
+			},
+		},
+		{
+			TestName: "false primitive types Source and mixed value and pointer types Target",
+			Source: &TestFlexTF23{
+				Field1: types.BoolValue(false),
+				Field2: types.BoolValue(false),
+				Field3: types.StringValue(""),
+				Field4: fwtypes.StringEnumValue(TestEnumScalar),
+			},
+			Target: &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{
+				Field1: false,
+				Field2: aws.Bool(false),
+				Field3: "",
+				Field4: TestEnumScalar,
+			},
+		},
+		{
+			TestName: "null primitive types Source and mixed value and pointer types Target",
+			Source: &TestFlexTF23{
+				Field1: types.BoolNull(),
+				Field2: types.BoolNull(),
+				Field3: types.StringNull(),
+				Field4: fwtypes.StringEnumNull[TestEnum](),
+			},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{},
+		},
+		{
+			TestName: "List/Set of string enum Source and slice of enum Target",
+			Source: &TestFlexStringEnumSliceTF01{
+				Field1: fwtypes.NewListValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{
+					fwtypes.StringEnumValue(TestEnumScalar),
+					fwtypes.StringEnumValue(TestEnumList),
+				}),
+				Field2: fwtypes.NewSetValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{
+					fwtypes.StringEnumValue(TestEnumList),
+				}),
+			},
+			Target: &TestFlexStringEnumSliceAWS01{},
+			WantTarget: &TestFlexStringEnumSliceAWS01{
+				Field1: []TestEnum{TestEnumScalar, TestEnumList},
+				Field2: []TestEnum{TestEnumList},
+			},
+		},
+		{
+			TestName: "null List/Set of string enum Source and slice of enum Target",
+			Source: &TestFlexStringEnumSliceTF01{
+				Field1: fwtypes.NewListValueOfNull[fwtypes.StringEnum[TestEnum]](ctx),
+				Field2: fwtypes.NewSetValueOfNull[fwtypes.StringEnum[TestEnum]](ctx),
+			},
+			Target:     &TestFlexStringEnumSliceAWS01{},
+			WantTarget: &TestFlexStringEnumSliceAWS01{},
+		},
+		{
+			TestName: "embedded struct Target",
+			Source: &TestFlexEmbeddedTF01{
+				Name:        types.StringValue("a"),
+				Description: types.StringValue("b"),
+				Priority:    types.Int64Value(1),
+			},
+			Target: &TestFlexEmbeddedAWS01{},
+			WantTarget: &TestFlexEmbeddedAWS01{
+				TestFlexEmbeddedCommonAWS01: TestFlexEmbeddedCommonAWS01{
+					Description: aws.String("b"),
+					Priority:    aws.Int32(1),
+				},
+				Name: aws.String("a"),
+			},
+		},
+		{
+			TestName: "nil embedded struct pointer Target",
+			Source: &TestFlexEmbeddedTF01{
+				Name:        types.StringValue("a"),
+				Description: types.StringValue("b"),
+				Priority:    types.Int64Value(1),
+			},
+			Target: &TestFlexEmbeddedAWS02{},
+			WantTarget: &TestFlexEmbeddedAWS02{
+				TestFlexEmbeddedCommonAWS01: &TestFlexEmbeddedCommonAWS01{
+					Description: aws.String("b"),
+					Priority:    aws.Int32(1),
+				},
+				Name: aws.String("a"),
+			},
+		},
+		{
+			TestName: "null field in nil embedded struct pointer Target",
+			Source: &TestFlexEmbeddedTF01{
+				Name:        types.StringValue("a"),
+				Description: types.StringNull(),
+				Priority:    types.Int64Null(),
+			},
+			Target: &TestFlexEmbeddedAWS02{},
+			WantTarget: &TestFlexEmbeddedAWS02{
+				Name: aws.String("a"),
+			},
+		},
 		{
 			TestName: "timestamp pointer",
 			Source: &TestFlexTimeTF01{
@@ -325,6 +596,101 @@ func TestExpand(t *testing.T) {
 	}
 }
 
+func TestExpandInt32Overflow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]any{
+		"int32":  &TestFlexAWS19{},
+		"*int32": &TestFlexAWS20{},
+	}
+
+	for name, target := range testCases {
+		target := target
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, &TestFlexTF02{Field1: types.Int64Value(math.MaxInt32 + 1)}, target)
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			var details []string
+			for _, d := range diags.Errors() {
+				details = append(details, d.Detail())
+			}
+			got := strings.Join(details, "\n")
+
+			for _, want := range []string{"value (2147483648) overflows int32", "convert (Field1)"} {
+				if !strings.Contains(got, want) {
+					t.Errorf("diagnostics %q do not contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandEmptyStringAsNull(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		TestName   string
+		Options    []AutoFlexOptionsFunc
+		Source     any
+		Target     any
+		WantTarget any
+	}{
+		{
+			TestName:   "empty string Source and *string Target",
+			Source:     &TestFlexTF01{Field1: types.StringValue("")},
+			Target:     &TestFlexAWS02{},
+			WantTarget: &TestFlexAWS02{Field1: aws.String("")},
+		},
+		{
+			TestName:   "empty string Source and *string Target with option",
+			Options:    []AutoFlexOptionsFunc{WithEmptyStringAsNull()},
+			Source:     &TestFlexTF01{Field1: types.StringValue("")},
+			Target:     &TestFlexAWS02{},
+			WantTarget: &TestFlexAWS02{},
+		},
+		{
+			TestName:   "string Source and *string Target with option",
+			Options:    []AutoFlexOptionsFunc{WithEmptyStringAsNull()},
+			Source:     &TestFlexTF01{Field1: types.StringValue("a")},
+			Target:     &TestFlexAWS02{},
+			WantTarget: &TestFlexAWS02{Field1: aws.String("a")},
+		},
+		{
+			TestName:   "empty string Source and string Target with option",
+			Options:    []AutoFlexOptionsFunc{WithEmptyStringAsNull()},
+			Source:     &TestFlexTF01{Field1: types.StringValue("")},
+			Target:     &TestFlexAWS01{Field1: "a"},
+			WantTarget: &TestFlexAWS01{Field1: ""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.Source, testCase.Target, testCase.Options...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandGeneric(t *testing.T) {
 	t.Parallel()
 
@@ -350,6 +716,24 @@ func TestExpandGeneric(t *testing.T) {
 			Target:     &TestFlexAWS06{},
 			WantTarget: &TestFlexAWS06{Field1: &TestFlexAWS01{Field1: "a"}},
 		},
+		{
+			TestName:   "empty list Source and *struct Target",
+			Source:     &TestFlexTF05{Field1: fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []TestFlexTF01{})},
+			Target:     &TestFlexAWS06{},
+			WantTarget: &TestFlexAWS06{},
+		},
+		{
+			TestName:   "empty set Source and *struct Target",
+			Source:     &TestFlexTF06{Field1: fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, []TestFlexTF01{})},
+			Target:     &TestFlexAWS06{},
+			WantTarget: &TestFlexAWS06{},
+		},
+		{
+			TestName:   "null list Source and *struct Target",
+			Source:     &TestFlexTF05{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)},
+			Target:     &TestFlexAWS06{},
+			WantTarget: &TestFlexAWS06{},
+		},
 		{
 			TestName:   "empty list Source and empty []struct Target",
 			Source:     &TestFlexTF05{Field1: fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []TestFlexTF01{})},
@@ -434,6 +818,27 @@ func TestExpandGeneric(t *testing.T) {
 				{Field1: "b"},
 			}},
 		},
+		{
+			TestName: "null nested list Source and nil []struct Target",
+			Source: &TestFlexComplexNestTF06{
+				InputContext:     fwtypes.NewListNestedObjectValueOfNull[TestFlexComplexNestTF07](ctx),
+				SampleUtterances: fwtypes.NewListValueOfNull[types.String](ctx),
+			},
+			Target:     &TestFlexComplexNestAWS06{},
+			WantTarget: &TestFlexComplexNestAWS06{},
+		},
+		{
+			TestName: "empty nested list Source and empty []struct Target",
+			Source: &TestFlexComplexNestTF06{
+				InputContext:     fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []TestFlexComplexNestTF07{}),
+				SampleUtterances: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{}),
+			},
+			Target: &TestFlexComplexNestAWS06{},
+			WantTarget: &TestFlexComplexNestAWS06{
+				InputContexts:    []TestFlexComplexNestAWS07{},
+				SampleUtterances: []string{},
+			},
+		},
 		{
 			TestName: "complex Source and complex Target",
 			Source: &TestFlexTF07{
@@ -725,6 +1130,25 @@ func TestExpandGeneric(t *testing.T) {
 				},
 			},
 		},
+		{
+			Context:  context.WithValue(ctx, MaxDepth, 3),
+			TestName: "maximum nesting depth exceeded",
+			Source: &TestFlexComplexNestTF01{
+				Intent: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexComplexNestTF03{
+					Name: types.StringValue("x"),
+					Slots: fwtypes.NewObjectMapValueMapOf[TestFlexComplexNestTF04](ctx, map[string]TestFlexComplexNestTF04{
+						"x": {
+							Shape: fwtypes.StringEnumValue(TestEnumList),
+							Value: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexComplexNestTF05{
+								InterpretedValue: types.StringValue("y"),
+							}),
+						},
+					}),
+				}),
+			},
+			Target:  &TestFlexComplexNestAWS01{},
+			WantErr: true,
+		},
 		{
 			TestName: "complex nesting",
 			Source: &TestFlexComplexNestTF01{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -330,6 +331,7 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		case basetypes.ListTypable:
 			//
 			// []string -> types.List(OfString).
+			// []enum -> types.List(OfStringEnum).
 			//
 			if vFrom.IsNil() {
 				to, d := tTo.ValueFromList(ctx, types.ListNull(types.StringType))
@@ -342,11 +344,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 				return diags
 			}
 
-			elements := make([]attr.Value, vFrom.Len())
-			for i := 0; i < vFrom.Len(); i++ {
-				elements[i] = types.StringValue(vFrom.Index(i).String())
+			tElem := stringElementType(tTo)
+			elements, d := stringElements(ctx, vFrom, tElem)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
 			}
-			list, d := types.ListValue(types.StringType, elements)
+			list, d := types.ListValue(tElem, elements)
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
@@ -364,6 +368,7 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		case basetypes.SetTypable:
 			//
 			// []string -> types.Set(OfString).
+			// []enum -> types.Set(OfStringEnum).
 			//
 			if vFrom.IsNil() {
 				to, d := tTo.ValueFromSet(ctx, types.SetNull(types.StringType))
@@ -376,11 +381,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 				return diags
 			}
 
-			elements := make([]attr.Value, vFrom.Len())
-			for i := 0; i < vFrom.Len(); i++ {
-				elements[i] = types.StringValue(vFrom.Index(i).String())
+			tElem := stringElementType(tTo)
+			elements, d := stringElements(ctx, vFrom, tElem)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
 			}
-			set, d := types.SetValue(types.StringType, elements)
+			set, d := types.SetValue(tElem, elements)
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
@@ -632,6 +639,18 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 					return diags
 				}
 			}
+		case reflect.Slice:
+			switch tMapElem.Elem().Kind() {
+			case reflect.String:
+				switch tTo := tTo.(type) {
+				case basetypes.MapTypable:
+					//
+					// map[string][]string -> types.Map(OfList(OfString)).
+					//
+					diags.Append(flattener.stringSliceMapToMap(ctx, vFrom, tTo, vTo)...)
+					return diags
+				}
+			}
 		}
 	}
 
@@ -643,6 +662,70 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 	return diags
 }
 
+// stringSliceMapToMap copies an AWS API map[string][]string value to a Plugin Framework Map whose elements are Lists of String.
+func (flattener autoFlattener) stringSliceMapToMap(ctx context.Context, vFrom reflect.Value, tTo basetypes.MapTypable, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tMap, ok := tTo.(attr.TypeWithElementType)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not have an element type: %s", tTo))
+		return diags
+	}
+
+	tElem, ok := tMap.ElementType().(basetypes.ListTypable)
+	if !ok {
+		tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+			"from": vFrom.Kind(),
+			"to":   tTo,
+		})
+		return diags
+	}
+
+	if vFrom.IsNil() {
+		to, d := tTo.ValueFromMap(ctx, types.MapNull(tElem))
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(to))
+		return diags
+	}
+
+	from := vFrom.Interface().(map[string][]string)
+	elements := make(map[string]attr.Value, len(from))
+	for k, v := range from {
+		list, d := types.ListValueFrom(ctx, types.StringType, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		elem, d := tElem.ValueFromList(ctx, list)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		elements[k] = elem
+	}
+
+	map_, d := types.MapValue(tElem, elements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	to, d := tTo.ValueFromMap(ctx, map_)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(to))
+	return diags
+}
+
 func (flattener autoFlattener) structMapToObjectMap(ctx context.Context, vFrom reflect.Value, tTo fwtypes.ObjectMapType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -723,8 +806,14 @@ func (flattener autoFlattener) structMapToObjectList(ctx context.Context, vFrom
 
 	t := reflect.ValueOf(to)
 
+	// Sort the keys so that the resulting list is ordered deterministically.
+	keys := vFrom.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
 	i := 0
-	for _, key := range vFrom.MapKeys() {
+	for _, key := range keys {
 		target, d := tTo.NewObjectPtr(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -893,3 +982,39 @@ func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 
 	return diags
 }
+
+// stringElementType returns the element type of a collection of string(ish) values.
+func stringElementType(tTo attr.Type) attr.Type {
+	if t, ok := tTo.(attr.TypeWithElementType); ok {
+		if tElem, ok := t.ElementType().(basetypes.StringTypable); ok {
+			return tElem
+		}
+	}
+
+	return types.StringType
+}
+
+// stringElements returns the elements of a slice of string(ish) values as Plugin Framework values of the specified type.
+func stringElements(ctx context.Context, vFrom reflect.Value, tElem attr.Type) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, vFrom.Len())
+	for i := 0; i < vFrom.Len(); i++ {
+		element := types.StringValue(vFrom.Index(i).String())
+
+		if tElem, ok := tElem.(basetypes.StringTypable); ok && !tElem.Equal(types.StringType) {
+			v, d := tElem.ValueFromString(ctx, element)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			elements[i] = v
+			continue
+		}
+
+		elements[i] = element
+	}
+
+	return elements, diags
+}
@@ -6,6 +6,8 @@ package flex
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -28,6 +31,8 @@ func TestFlatten(t *testing.T) {
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
 
+	testJSON := `{"Key1":"Value","Key2":[1,2,3]}`
+
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
 
@@ -109,6 +114,18 @@ func TestFlatten(t *testing.T) {
 			Target:     &TestFlexTF01{},
 			WantTarget: &TestFlexTF01{Field1: types.StringValue("a")},
 		},
+		{
+			TestName:   "AWS-only fields Source and single string Target",
+			Source:     &TestFlexAWS24{Field1: aws.String("a"), RequestId: aws.String("b"), ResultMetadata: TestFlexAWS01{Field1: "c"}},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringValue("a")},
+		},
+		{
+			TestName:   "single enum Source and single string Target",
+			Source:     &TestFlexAWS22{Field1: TestEnumList},
+			Target:     &TestFlexTF01{},
+			WantTarget: &TestFlexTF01{Field1: types.StringValue("List")},
+		},
 		{
 			TestName:   "single string Source and single int64 Target",
 			Source:     &TestFlexAWS01{Field1: "a"},
@@ -134,6 +151,33 @@ func TestFlatten(t *testing.T) {
 				Field12: types.BoolNull(),
 			},
 		},
+		{
+			TestName: "nil primitive pointer types Source and primitive types Target",
+			Source:   &TestFlexAWS23{},
+			Target:   &TestFlexTF21{},
+			WantTarget: &TestFlexTF21{
+				Field1: types.StringNull(),
+				Field2: types.Int64Null(),
+				Field3: types.Int64Null(),
+				Field4: types.BoolNull(),
+			},
+		},
+		{
+			TestName: "zero value primitive pointer types Source and primitive types Target",
+			Source: &TestFlexAWS23{
+				Field1: aws.String(""),
+				Field2: aws.Int32(0),
+				Field3: aws.Int64(0),
+				Field4: aws.Bool(false),
+			},
+			Target: &TestFlexTF21{},
+			WantTarget: &TestFlexTF21{
+				Field1: types.StringValue(""),
+				Field2: types.Int64Value(0),
+				Field3: types.Int64Value(0),
+				Field4: types.BoolValue(false),
+			},
+		},
 		{
 			TestName: "primtive types Source and primtive types Target",
 			Source: &TestFlexAWS04{
@@ -395,6 +439,70 @@ func TestFlatten(t *testing.T) {
 			Target:     &TestFlexTF17{},
 			WantTarget: &TestFlexTF17{Field1: fwtypes.ARNNull()},
 		},
+		{
+			TestName:   "single *string Source and single JSONString Target",
+			Source:     &TestFlexAWS02{Field1: aws.String(testJSON)},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: fwtypes.JSONStringValue(testJSON)},
+		},
+		{
+			TestName:   "single nil *string Source and single JSONString Target",
+			Source:     &TestFlexAWS02{},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: fwtypes.JSONStringNull()},
+		},
+		{
+			TestName: "slice of enum Source and List/Set of string enum Target",
+			Source: &TestFlexStringEnumSliceAWS01{
+				Field1: []TestEnum{TestEnumScalar, TestEnumList},
+				Field2: []TestEnum{TestEnumList},
+			},
+			Target: &TestFlexStringEnumSliceTF01{},
+			WantTarget: &TestFlexStringEnumSliceTF01{
+				Field1: fwtypes.NewListValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{
+					fwtypes.StringEnumValue(TestEnumScalar),
+					fwtypes.StringEnumValue(TestEnumList),
+				}),
+				Field2: fwtypes.NewSetValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{
+					fwtypes.StringEnumValue(TestEnumList),
+				}),
+			},
+		},
+		{
+			TestName: "nil slice of enum Source and List/Set of string enum Target",
+			Source:   &TestFlexStringEnumSliceAWS01{},
+			Target:   &TestFlexStringEnumSliceTF01{},
+			WantTarget: &TestFlexStringEnumSliceTF01{
+				Field1: fwtypes.NewListValueOfNull[fwtypes.StringEnum[TestEnum]](ctx),
+				Field2: fwtypes.NewSetValueOfNull[fwtypes.StringEnum[TestEnum]](ctx),
+			},
+		},
+		{
+			TestName: "embedded struct Source",
+			Source: &TestFlexEmbeddedAWS01{
+				TestFlexEmbeddedCommonAWS01: TestFlexEmbeddedCommonAWS01{
+					Description: aws.String("b"),
+					Priority:    aws.Int32(1),
+				},
+				Name: aws.String("a"),
+			},
+			Target: &TestFlexEmbeddedTF01{},
+			WantTarget: &TestFlexEmbeddedTF01{
+				Name:        types.StringValue("a"),
+				Description: types.StringValue("b"),
+				Priority:    types.Int64Value(1),
+			},
+		},
+		{
+			TestName: "nil embedded struct pointer Source",
+			Source: &TestFlexEmbeddedAWS02{
+				Name: aws.String("a"),
+			},
+			Target: &TestFlexEmbeddedTF01{},
+			WantTarget: &TestFlexEmbeddedTF01{
+				Name: types.StringValue("a"),
+			},
+		},
 		{
 			TestName: "timestamp pointer",
 			Source: &TestFlexTimeAWS01{
@@ -854,6 +962,25 @@ func TestFlattenGeneric(t *testing.T) {
 				}),
 			},
 		},
+		{
+			Context:  context.WithValue(ctx, MaxDepth, 3),
+			TestName: "maximum nesting depth exceeded",
+			Source: &TestFlexComplexNestAWS01{
+				Intent: &TestFlexComplexNestAWS03{
+					Name: aws.String("x"),
+					Slots: map[string]TestFlexComplexNestAWS04{
+						"x": {
+							Shape: TestEnumList,
+							Value: &TestFlexComplexNestAWS05{
+								InterpretedValue: aws.String("y"),
+							},
+						},
+					},
+				},
+			},
+			Target:  &TestFlexComplexNestTF01{},
+			WantErr: true,
+		},
 		{
 			TestName: "complex nesting",
 			Source: &TestFlexComplexNestAWS01{
@@ -931,3 +1058,469 @@ func TestFlattenGeneric(t *testing.T) {
 		})
 	}
 }
+
+func TestStringEnumSliceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]TestFlexStringEnumSliceAWS01{
+		"full": {
+			Field1: []TestEnum{TestEnumScalar, TestEnumList},
+			Field2: []TestEnum{TestEnumScalar},
+		},
+		"empty": {},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var tf TestFlexStringEnumSliceTF01
+			if diags := Flatten(ctx, &testCase, &tf); diags.HasError() {
+				t.Fatalf("unexpected Flatten error: %v", diags)
+			}
+
+			var got TestFlexStringEnumSliceAWS01
+			if diags := Expand(ctx, &tf, &got); diags.HasError() {
+				t.Fatalf("unexpected Expand error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenMapBlockKeyOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	source := &TestFlexMapBlockKeyAWS01{
+		MapBlock: map[string]TestFlexMapBlockKeyAWS02{
+			"z": {
+				Attr1: "e",
+				Attr2: "f",
+			},
+			"x": {
+				Attr1: "a",
+				Attr2: "b",
+			},
+			"y": {
+				Attr1: "c",
+				Attr2: "d",
+			},
+		},
+	}
+	want := &TestFlexMapBlockKeyTF01{
+		MapBlock: fwtypes.NewListNestedObjectValueOfValueSlice[TestFlexMapBlockKeyTF02](ctx, []TestFlexMapBlockKeyTF02{
+			{
+				MapBlockKey: types.StringValue("x"),
+				Attr1:       types.StringValue("a"),
+				Attr2:       types.StringValue("b"),
+			},
+			{
+				MapBlockKey: types.StringValue("y"),
+				Attr1:       types.StringValue("c"),
+				Attr2:       types.StringValue("d"),
+			},
+			{
+				MapBlockKey: types.StringValue("z"),
+				Attr1:       types.StringValue("e"),
+				Attr2:       types.StringValue("f"),
+			},
+		}),
+	}
+
+	// Go map iteration order is randomized, so flatten repeatedly.
+	for i := 0; i < 20; i++ {
+		target := &TestFlexMapBlockKeyTF01{}
+
+		if diags := Flatten(ctx, source, target); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if diff := cmp.Diff(target, want); diff != "" {
+			t.Fatalf("unexpected diff on run %d (+wanted, -got): %s", i, diff)
+		}
+	}
+}
+
+func TestSetRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]TestFlexSetAWS01{
+		"full": {
+			Field1: []string{"c", "a", "b"},
+			Field2: aws.StringSlice([]string{"y", "x"}),
+			Field3: []TestFlexAWS01{{Field1: "b"}, {Field1: "a"}},
+		},
+		"empty": {
+			Field1: []string{},
+			Field2: []*string{},
+			Field3: []TestFlexAWS01{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var tf TestFlexSetTF01
+			if diags := Flatten(ctx, &testCase, &tf); diags.HasError() {
+				t.Fatalf("unexpected Flatten error: %v", diags)
+			}
+
+			if got, want := len(tf.Field1.Elements()), len(testCase.Field1); got != want {
+				t.Errorf("unexpected set length, got: %d, want: %d", got, want)
+			}
+
+			var got TestFlexSetAWS01
+			if diags := Expand(ctx, &tf, &got); diags.HasError() {
+				t.Fatalf("unexpected Expand error: %v", diags)
+			}
+
+			// Sets are unordered, so compare the elements irrespective of order.
+			opts := []cmp.Option{
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+				cmpopts.SortSlices(func(a, b *string) bool { return aws.ToString(a) < aws.ToString(b) }),
+				cmpopts.SortSlices(func(a, b TestFlexAWS01) bool { return a.Field1 < b.Field1 }),
+			}
+			if diff := cmp.Diff(got, testCase, opts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapOfStringRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("AWS to TF to AWS", func(t *testing.T) {
+		t.Parallel()
+
+		want := TestFlexAWS13{
+			FieldInner: map[string]string{
+				"x": "a",
+				"y": "b",
+			},
+		}
+
+		var tf TestFlexTF11
+		if diags := Flatten(ctx, &want, &tf); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		var got TestFlexAWS13
+		if diags := Expand(ctx, &tf, &got); diags.HasError() {
+			t.Fatalf("unexpected Expand error: %v", diags)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("TF to AWS to TF", func(t *testing.T) {
+		t.Parallel()
+
+		want := TestFlexTF11{
+			FieldInner: fwtypes.NewMapValueOf(ctx, map[string]basetypes.StringValue{
+				"x": types.StringValue("a"),
+				"y": types.StringValue("b"),
+			}),
+		}
+
+		var aws TestFlexAWS13
+		if diags := Expand(ctx, &want, &aws); diags.HasError() {
+			t.Fatalf("unexpected Expand error: %v", diags)
+		}
+
+		var got TestFlexTF11
+		if diags := Flatten(ctx, &aws, &got); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("null map expands to nil", func(t *testing.T) {
+		t.Parallel()
+
+		tf := TestFlexTF11{
+			FieldInner: fwtypes.NewMapValueOfNull[basetypes.StringValue](ctx),
+		}
+
+		var got TestFlexAWS13
+		if diags := Expand(ctx, &tf, &got); diags.HasError() {
+			t.Fatalf("unexpected Expand error: %v", diags)
+		}
+
+		if got.FieldInner != nil {
+			t.Errorf("expected nil map, got: %v", got.FieldInner)
+		}
+	})
+}
+
+func TestMapOfStringListRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("AWS to TF to AWS", func(t *testing.T) {
+		t.Parallel()
+
+		want := TestFlexAWS27{
+			Field1: map[string][]string{
+				"x": {"a", "b"},
+				"y": {"c", "d"},
+			},
+		}
+
+		var tf TestFlexTF24
+		if diags := Flatten(ctx, &want, &tf); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		if got, want := len(tf.Field1.Elements()), 2; got != want {
+			t.Fatalf("unexpected number of map elements, got: %d, want: %d", got, want)
+		}
+
+		var got TestFlexAWS27
+		if diags := Expand(ctx, &tf, &got); diags.HasError() {
+			t.Fatalf("unexpected Expand error: %v", diags)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("TF to AWS to TF", func(t *testing.T) {
+		t.Parallel()
+
+		want := TestFlexTF24{
+			Field1: fwtypes.NewMapValueOf(ctx, map[string]fwtypes.ListValueOf[types.String]{
+				"x": fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+				"y": fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("c"), types.StringValue("d")}),
+			}),
+		}
+
+		var aws TestFlexAWS27
+		if diags := Expand(ctx, &want, &aws); diags.HasError() {
+			t.Fatalf("unexpected Expand error: %v", diags)
+		}
+
+		if diff := cmp.Diff(aws.Field1, map[string][]string{"x": {"a", "b"}, "y": {"c", "d"}}); diff != "" {
+			t.Errorf("unexpected Expand diff (+wanted, -got): %s", diff)
+		}
+
+		var got TestFlexTF24
+		if diags := Flatten(ctx, &aws, &got); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		if !got.Field1.Equal(want.Field1) {
+			t.Errorf("unexpected Flatten result, got: %s, want: %s", got.Field1, want.Field1)
+		}
+	})
+
+	t.Run("nil map flattens to null", func(t *testing.T) {
+		t.Parallel()
+
+		var got TestFlexTF24
+		if diags := Flatten(ctx, &TestFlexAWS27{}, &got); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		if !got.Field1.IsNull() {
+			t.Errorf("expected null map, got: %s", got.Field1)
+		}
+	})
+}
+
+func TestUnexportedFieldDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		convert func() diag.Diagnostics
+		want    string
+	}{
+		// Unexported AWS SDK fields are not reported.
+		"Expand": {
+			convert: func() diag.Diagnostics {
+				return Expand(ctx, &TestFlexTF01{Field1: types.StringValue("a")}, &TestFlexAWS21{})
+			},
+		},
+		"Flatten": {
+			convert: func() diag.Diagnostics {
+				return Flatten(ctx, &TestFlexAWS02{Field1: aws.String("a")}, &TestFlexTF20{})
+			},
+			want: "field (Field1) matches unexported field (field1) in flex.TestFlexTF20, which cannot be set",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.convert()
+
+			if testCase.want == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			var details []string
+			for _, d := range diags.Errors() {
+				details = append(details, d.Detail())
+			}
+
+			if got := strings.Join(details, "\n"); !strings.Contains(got, testCase.want) {
+				t.Errorf("diagnostics %q do not contain %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMapBlockKeyNestedRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	want := TestFlexMapBlockKeyAWS04{
+		MapBlock: map[string]TestFlexMapBlockKeyAWS05{
+			"x": {
+				Shape: "Scalar",
+				Value: &TestFlexMapBlockKeyAWS06{
+					InterpretedValue: aws.String("a"),
+				},
+			},
+			"y": {
+				Shape: "List",
+				Values: []TestFlexMapBlockKeyAWS07{
+					{
+						Shape: "Scalar",
+						Value: &TestFlexMapBlockKeyAWS06{
+							InterpretedValue: aws.String("b"),
+						},
+					},
+					{
+						Shape: "Scalar",
+						Value: &TestFlexMapBlockKeyAWS06{
+							InterpretedValue: aws.String("c"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var tf TestFlexMapBlockKeyTF06
+	if diags := Flatten(ctx, &want, &tf); diags.HasError() {
+		t.Fatalf("unexpected Flatten error: %v", diags)
+	}
+
+	elems, diags := tf.MapBlock.ToSlice(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected ToSlice error: %v", diags)
+	}
+
+	keys := make([]string, 0, len(elems))
+	for _, v := range elems {
+		keys = append(keys, v.MapBlockKey.ValueString())
+	}
+	sort.Strings(keys)
+
+	if diff := cmp.Diff(keys, []string{"x", "y"}); diff != "" {
+		t.Errorf("unexpected map block keys diff (+wanted, -got): %s", diff)
+	}
+
+	var got TestFlexMapBlockKeyAWS04
+	if diags := Expand(ctx, &tf, &got); diags.HasError() {
+		t.Fatalf("unexpected Expand error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFlattenPolicyDocumentSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		configured = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "mq:*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}`
+		normalized = `{"Statement":[{"Effect":"Allow","Resource":"*","Action":"mq:*"}],"Version":"2012-10-17"}`
+	)
+
+	t.Run("JSONString", func(t *testing.T) {
+		t.Parallel()
+
+		var target TestFlexTF19
+		if diags := Flatten(ctx, &TestFlexAWS02{Field1: aws.String(normalized)}, &target); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		equal, diags := fwtypes.JSONStringValue(configured).StringSemanticEquals(ctx, target.Field1)
+		if diags.HasError() {
+			t.Fatalf("unexpected StringSemanticEquals error: %v", diags)
+		}
+
+		if !equal {
+			t.Errorf("expected %s to be semantically equal to %s", target.Field1, configured)
+		}
+	})
+
+	t.Run("IAMPolicy", func(t *testing.T) {
+		t.Parallel()
+
+		var target TestFlexTF25
+		if diags := Flatten(ctx, &TestFlexAWS02{Field1: aws.String(normalized)}, &target); diags.HasError() {
+			t.Fatalf("unexpected Flatten error: %v", diags)
+		}
+
+		if diff := cmp.Diff(target, TestFlexTF25{Field1: fwtypes.IAMPolicyValue(normalized)}); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		equal, diags := fwtypes.IAMPolicyValue(configured).StringSemanticEquals(ctx, target.Field1)
+		if diags.HasError() {
+			t.Fatalf("unexpected StringSemanticEquals error: %v", diags)
+		}
+
+		if !equal {
+			t.Errorf("expected %s to be semantically equal to %s", target.Field1, configured)
+		}
+	})
+}
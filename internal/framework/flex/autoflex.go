@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
@@ -21,6 +22,17 @@ const (
 	MapBlockKey                                = "MapBlockKey"
 )
 
+type MaxDepthCtxKey string
+
+const (
+	// MaxDepth is the context key used to override the maximum struct nesting depth, e.g. for recursive shapes
+	// such as SlotValueOverride.Values.
+	MaxDepth MaxDepthCtxKey = "MAX_DEPTH"
+	depth    MaxDepthCtxKey = "DEPTH"
+
+	defaultMaxDepth = 32
+)
+
 // Expand  = TF -->  AWS
 // Flatten = AWS --> TF
 
@@ -80,15 +92,27 @@ var (
 func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	ctx, d := autoFlexIncrementDepth(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
 	valFrom, valTo, d := autoFlexValues(ctx, from, to)
 	diags.Append(d...)
 	if diags.HasError() {
 		return diags
 	}
 
-	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
-		field := typFrom.Field(i)
-		if field.PkgPath != "" {
+	// Only an AWS field can be reported as matching an unsettable field, as AWS SDK structs have unexported fields of their own.
+	_, flattening := flexer.(*autoFlattener)
+
+	// Fields of anonymous (embedded) structs are promoted, so traverse the visible fields.
+	for _, field := range reflect.VisibleFields(valFrom.Type()) {
+		if field.Anonymous && indirectKind(field.Type) == reflect.Struct {
+			continue // Embedded struct fields are visited individually.
+		}
+		if !field.IsExported() {
 			continue // Skip unexported fields.
 		}
 		fieldName := field.Name
@@ -99,15 +123,29 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue
 		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom)
+		fromFieldVal, err := valFrom.FieldByIndexErr(field.Index)
+		if err != nil {
+			continue // Field is promoted through a nil embedded struct pointer.
+		}
+
+		// A null (or unknown) value is not set, so no nil embedded struct pointer need be allocated for it.
+		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, !isNullOrUnknown(fromFieldVal))
 		if !toFieldVal.IsValid() {
+			if toFieldName, ok := findUnexportedField(fieldName, valTo); ok && flattening {
+				diags.AddError("AutoFlEx", fmt.Sprintf("field (%s) matches unexported field (%s) in %s, which cannot be set", fieldName, toFieldName, valTo.Type()))
+				return diags
+			}
 			continue // Corresponding field not found in to.
 		}
 		if !toFieldVal.CanSet() {
-			continue // Corresponding field value can't be changed.
+			if _, ok := valTo.Type().FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, fieldName) }); ok && flattening {
+				diags.AddError("AutoFlEx", fmt.Sprintf("field (%s) matches a field in %s that cannot be set", fieldName, valTo.Type()))
+				return diags
+			}
+			continue // Corresponding field cannot be set.
 		}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		diags.Append(flexer.convert(ctx, fromFieldVal, toFieldVal)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
@@ -117,9 +155,28 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) reflect.Value {
+// autoFlexIncrementDepth returns a context recording one more level of struct nesting,
+// or an error if the maximum nesting depth is exceeded.
+func autoFlexIncrementDepth(ctx context.Context) (context.Context, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxDepth := defaultMaxDepth
+	if v, ok := ctx.Value(MaxDepth).(int); ok && v > 0 {
+		maxDepth = v
+	}
+
+	n, _ := ctx.Value(depth).(int)
+	if n++; n > maxDepth {
+		diags.AddError("AutoFlEx", fmt.Sprintf("maximum nesting depth (%d) exceeded", maxDepth))
+		return ctx, diags
+	}
+
+	return context.WithValue(ctx, depth, n), diags
+}
+
+func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, alloc bool) reflect.Value {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
+	if v := fieldByName(valTo, fieldNameFrom, alloc); v.IsValid() {
 		return v
 	}
 
@@ -130,30 +187,33 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	// to make sure fuzzy matches are not in "from".
 
 	// second precedence is exact match (case insensitive)
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
-		field := typTo.Field(i)
-		if field.PkgPath != "" {
+	for _, field := range reflect.VisibleFields(valTo.Type()) {
+		if field.Anonymous && indirectKind(field.Type) == reflect.Struct {
+			continue // Embedded struct fields are visited individually.
+		}
+		if !field.IsExported() {
 			continue // Skip unexported fields.
 		}
 		fieldNameTo := field.Name
 		if fieldNameTo == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			if v := fieldByName(valTo, fieldNameTo, alloc); v.IsValid() {
+				return v
+			}
 		}
 	}
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Plural(fieldNameFrom), alloc); v.IsValid() {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Singular(fieldNameFrom), alloc); v.IsValid() {
 			return v
 		}
 	}
@@ -164,20 +224,75 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 			// so it will only recurse once
 			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
 			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom)
+				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom, alloc)
 			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom)
+			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom, alloc)
 		}
 	}
 
 	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	return reflect.Value{}
 }
 
-func fieldExistsInStruct(field string, str reflect.Value) bool {
-	if v := str.FieldByName(field); v.IsValid() {
-		return true
+// fieldByName returns the field of struct `str` with the specified name.
+// Unlike reflect.Value.FieldByName it does not panic for a field promoted through a nil embedded struct pointer,
+// instead allocating the embedded struct if `alloc` is true. The zero Value is returned if there is no such field or the
+// embedded struct pointer is not allocated or cannot be set.
+func fieldByName(str reflect.Value, name string, alloc bool) reflect.Value {
+	field, ok := str.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}
+	}
+
+	v := str
+	for i, x := range field.Index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+
+	return v
+}
+
+// isNullOrUnknown returns whether `v` is a null or unknown Plugin Framework value.
+func isNullOrUnknown(v reflect.Value) bool {
+	if v, ok := v.Interface().(attr.Value); ok {
+		return v.IsNull() || v.IsUnknown()
 	}
 
 	return false
 }
+
+// findUnexportedField returns the name of the unexported field in struct `str` whose name matches `field` (case insensitive).
+// Such a field cannot be set by reflection, so a value mapped to it would otherwise be silently dropped.
+func findUnexportedField(field string, str reflect.Value) (string, bool) {
+	for _, f := range reflect.VisibleFields(str.Type()) {
+		if !f.IsExported() && !f.Anonymous && strings.EqualFold(field, f.Name) {
+			return f.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func fieldExistsInStruct(field string, str reflect.Value) bool {
+	_, ok := str.Type().FieldByName(field)
+
+	return ok
+}
+
+// indirectKind returns the Kind of the type, or of its element type if it is a pointer.
+func indirectKind(typ reflect.Type) reflect.Kind {
+	if typ.Kind() == reflect.Ptr {
+		return typ.Elem().Kind()
+	}
+
+	return typ.Kind()
+}
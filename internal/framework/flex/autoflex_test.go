@@ -213,6 +213,108 @@ type TestFlexAWS17 struct {
 	FieldOuter TestFlexAWS14
 }
 
+type TestFlexAWS19 struct {
+	Field1 int32
+}
+
+type TestFlexAWS20 struct {
+	Field1 *int32
+}
+
+// TestFlexTF20 has an unexported field that matches an AWS field.
+type TestFlexTF20 struct {
+	field1 types.String `tfsdk:"field1"` //nolint:unused // testing unexported field handling
+}
+
+// TestFlexAWS21 has an unexported field that matches a Terraform field, as AWS SDK structs can.
+type TestFlexAWS21 struct {
+	field1 *string //nolint:unused // testing unexported field handling
+}
+
+type TestFlexTF21 struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.Int64  `tfsdk:"field2"`
+	Field3 types.Int64  `tfsdk:"field3"`
+	Field4 types.Bool   `tfsdk:"field4"`
+}
+
+type TestFlexAWS22 struct {
+	Field1 TestEnum
+}
+
+// All primitive pointer types, e.g. optional fields in a Describe output.
+type TestFlexAWS23 struct {
+	Field1 *string
+	Field2 *int32
+	Field3 *int64
+	Field4 *bool
+}
+
+type TestFlexTF22 struct {
+	Field1 fwtypes.Duration `tfsdk:"field1"`
+	Field2 fwtypes.Duration `tfsdk:"field2"`
+	Field3 fwtypes.Duration `tfsdk:"field3"`
+}
+
+type TestFlexTF26 struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3"`
+}
+
+// Durations in seconds, e.g. *InSeconds fields.
+type TestFlexAWS25 struct {
+	Field1 int32
+	Field2 *int32
+	Field3 *int64
+}
+
+type TestFlexTF23 struct {
+	Field1 types.Bool                   `tfsdk:"field1"`
+	Field2 types.Bool                   `tfsdk:"field2"`
+	Field3 types.String                 `tfsdk:"field3"`
+	Field4 fwtypes.StringEnum[TestEnum] `tfsdk:"field4"`
+}
+
+// Mixed value and pointer fields, e.g. DialogCodeHookSettings.Enabled.
+type TestFlexAWS26 struct {
+	Field1 bool
+	Field2 *bool
+	Field3 string
+	Field4 TestEnum
+}
+
+type TestFlexTF24 struct {
+	Field1 fwtypes.MapValueOf[fwtypes.ListValueOf[types.String]] `tfsdk:"field1"`
+}
+
+type TestFlexTF25 struct {
+	Field1 fwtypes.IAMPolicy `tfsdk:"field1"`
+}
+
+type TestFlexAWS27 struct {
+	Field1 map[string][]string
+}
+
+// Fields with no TF counterpart, e.g. request metadata in a Describe output.
+type TestFlexAWS24 struct {
+	Field1         *string
+	RequestId      *string
+	ResultMetadata TestFlexAWS01
+}
+
+type TestFlexSetTF01 struct {
+	Field1 types.Set                                    `tfsdk:"field1"`
+	Field2 fwtypes.SetValueOf[types.String]             `tfsdk:"field2"`
+	Field3 fwtypes.SetNestedObjectValueOf[TestFlexTF01] `tfsdk:"field3"`
+}
+
+type TestFlexSetAWS01 struct {
+	Field1 []string
+	Field2 []*string
+	Field3 []TestFlexAWS01
+}
+
 type TestEnum string
 
 // Enum values for SlotShape
@@ -228,6 +330,16 @@ func (TestEnum) Values() []TestEnum {
 	}
 }
 
+// Slices of string enums.
+type TestFlexStringEnumSliceTF01 struct {
+	Field1 fwtypes.ListValueOf[fwtypes.StringEnum[TestEnum]] `tfsdk:"field1"`
+	Field2 fwtypes.SetValueOf[fwtypes.StringEnum[TestEnum]]  `tfsdk:"field2"`
+}
+type TestFlexStringEnumSliceAWS01 struct {
+	Field1 []TestEnum
+	Field2 []TestEnum
+}
+
 type TestFlexComplexNestTF01 struct { // ie, DialogState
 	DialogAction      fwtypes.ListNestedObjectValueOf[TestFlexComplexNestTF02] `tfsdk:"dialog_action"`
 	Intent            fwtypes.ListNestedObjectValueOf[TestFlexComplexNestTF03] `tfsdk:"intent"`
@@ -276,6 +388,22 @@ type TestFlexComplexNestAWS05 struct { // ie, SlotValue
 	InterpretedValue *string
 }
 
+type TestFlexComplexNestTF06 struct { // ie, Intent
+	InputContext     fwtypes.ListNestedObjectValueOf[TestFlexComplexNestTF07] `tfsdk:"input_context"`
+	SampleUtterances fwtypes.ListValueOf[types.String]                        `tfsdk:"sample_utterances"`
+}
+type TestFlexComplexNestAWS06 struct { // ie, Intent
+	InputContexts    []TestFlexComplexNestAWS07
+	SampleUtterances []string
+}
+
+type TestFlexComplexNestTF07 struct { // ie, InputContext
+	Name types.String `tfsdk:"name"`
+}
+type TestFlexComplexNestAWS07 struct { // ie, InputContext
+	Name *string
+}
+
 type TestFlexPluralityTF01 struct {
 	Value types.String `tfsdk:"Value"`
 }
@@ -288,6 +416,29 @@ type TestFlexTF17 struct {
 	Field1 fwtypes.ARN `tfsdk:"field1"`
 }
 
+type TestFlexTF19 struct {
+	Field1 fwtypes.JSONString `tfsdk:"field1"`
+}
+
+// Embedded (anonymous) struct fields.
+type TestFlexEmbeddedTF01 struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Priority    types.Int64  `tfsdk:"priority"`
+}
+type TestFlexEmbeddedCommonAWS01 struct { // ie, CommonIntentFields
+	Description *string
+	Priority    *int32
+}
+type TestFlexEmbeddedAWS01 struct {
+	TestFlexEmbeddedCommonAWS01
+	Name *string
+}
+type TestFlexEmbeddedAWS02 struct {
+	*TestFlexEmbeddedCommonAWS01
+	Name *string
+}
+
 // List/Set/Map of string types.
 type TestFlexTF18 struct {
 	Field1 fwtypes.ListValueOf[types.String] `tfsdk:"field1"`
@@ -331,3 +482,36 @@ type TestFlexMapBlockKeyTF05 struct {
 	Attr1       types.String                 `tfsdk:"attr1"`
 	Attr2       types.String                 `tfsdk:"attr2"`
 }
+
+type TestFlexMapBlockKeyTF06 struct {
+	MapBlock fwtypes.ListNestedObjectValueOf[TestFlexMapBlockKeyTF07] `tfsdk:"map_block"`
+}
+type TestFlexMapBlockKeyTF07 struct {
+	MapBlockKey types.String                                             `tfsdk:"map_block_key"`
+	Shape       types.String                                             `tfsdk:"shape"`
+	Value       fwtypes.ListNestedObjectValueOf[TestFlexMapBlockKeyTF08] `tfsdk:"value"`
+	Values      fwtypes.ListNestedObjectValueOf[TestFlexMapBlockKeyTF09] `tfsdk:"values"`
+}
+type TestFlexMapBlockKeyTF08 struct {
+	InterpretedValue types.String `tfsdk:"interpreted_value"`
+}
+type TestFlexMapBlockKeyTF09 struct {
+	Shape types.String                                             `tfsdk:"shape"`
+	Value fwtypes.ListNestedObjectValueOf[TestFlexMapBlockKeyTF08] `tfsdk:"value"`
+}
+
+type TestFlexMapBlockKeyAWS04 struct {
+	MapBlock map[string]TestFlexMapBlockKeyAWS05
+}
+type TestFlexMapBlockKeyAWS05 struct {
+	Shape  string
+	Value  *TestFlexMapBlockKeyAWS06
+	Values []TestFlexMapBlockKeyAWS07
+}
+type TestFlexMapBlockKeyAWS06 struct {
+	InterpretedValue *string
+}
+type TestFlexMapBlockKeyAWS07 struct {
+	Shape string
+	Value *TestFlexMapBlockKeyAWS06
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package flextest provides helpers for testing AutoFlEx support of resource and data source models.
+package flextest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// StringEnumRoundTrip asserts that every member of the AWS SDK for Go v2 enum type `T`
+// round-trips through Expand and Flatten via `fwtypes.StringEnum[T]`.
+func StringEnumRoundTrip[T enum.Valueser[T]](t *testing.T) {
+	t.Helper()
+
+	type tfObject struct {
+		Value fwtypes.StringEnum[T] `tfsdk:"value"`
+	}
+	type apiObject struct {
+		Value T
+	}
+
+	ctx := context.Background()
+	values := enum.EnumValues[T]()
+
+	if len(values) == 0 {
+		t.Fatalf("%T has no values", *new(T))
+	}
+
+	for _, v := range values {
+		var expanded apiObject
+		if diags := flex.Expand(ctx, &tfObject{Value: fwtypes.StringEnumValue(v)}, &expanded); diags.HasError() {
+			t.Errorf("expanding %T(%q): %v", v, v, diags)
+			continue
+		}
+		if expanded.Value != v {
+			t.Errorf("expanding %T(%q): got %q", v, v, expanded.Value)
+		}
+
+		var flattened tfObject
+		if diags := flex.Flatten(ctx, &expanded, &flattened); diags.HasError() {
+			t.Errorf("flattening %T(%q): %v", v, v, diags)
+			continue
+		}
+		if want := fwtypes.StringEnumValue(v); !flattened.Value.Equal(want) {
+			t.Errorf("flattening %T(%q): got %s", v, v, flattened.Value)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flextest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex/flextest"
+)
+
+type testEnum string
+
+const (
+	testEnumScalar testEnum = "Scalar"
+	testEnumList   testEnum = "List"
+)
+
+func (testEnum) Values() []testEnum {
+	return []testEnum{
+		testEnumScalar,
+		testEnumList,
+	}
+}
+
+func TestStringEnumRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	flextest.StringEnumRoundTrip[testEnum](t)
+}
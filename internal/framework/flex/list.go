@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// ExpandFrameworkStringList converts a framework List value to a slice of string pointers.
+//
+// A null List is converted to a nil slice.
+// An empty List is converted to an empty slice.
 func ExpandFrameworkStringList(ctx context.Context, v basetypes.ListValuable) []*string {
 	var output []*string
 
@@ -20,6 +24,10 @@ func ExpandFrameworkStringList(ctx context.Context, v basetypes.ListValuable) []
 	return output
 }
 
+// ExpandFrameworkStringValueList converts a framework List value to a slice of string values.
+//
+// A null List is converted to a nil slice.
+// An empty List is converted to an empty slice.
 func ExpandFrameworkStringValueList(ctx context.Context, v basetypes.ListValuable) []string {
 	var output []string
 
@@ -158,3 +158,16 @@ func (Duration) Type(context.Context) attr.Type {
 func (v Duration) ValueDuration() time.Duration {
 	return v.value
 }
+
+// ValueSeconds returns the known time.Duration value as a number of seconds. If Duration is null or unknown, returns 0.
+// An error diagnostic is returned if the value is not a whole number of seconds.
+func (v Duration) ValueSeconds() (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.value%time.Second != 0 {
+		diags.AddError("Duration Value Error", fmt.Sprintf("Value %q is not a whole number of seconds.", v.ValueString()))
+		return 0, diags
+	}
+
+	return int64(v.value / time.Second), diags
+}
@@ -34,6 +34,10 @@ func TestDurationTypeValueFromTerraform(t *testing.T) {
 			val:      tftypes.NewValue(tftypes.String, "2h"),
 			expected: fwtypes.DurationValue("2h"),
 		},
+		"seconds": {
+			val:      tftypes.NewValue(tftypes.String, "300"),
+			expected: fwtypes.DurationUnknown(),
+		},
 		"invalid duration": {
 			val:      tftypes.NewValue(tftypes.String, "not ok"),
 			expected: fwtypes.DurationUnknown(),
@@ -80,6 +84,10 @@ func TestDurationTypeValidate(t *testing.T) {
 		"valid string": {
 			val: tftypes.NewValue(tftypes.String, "2h"),
 		},
+		"seconds string": {
+			val:         tftypes.NewValue(tftypes.String, "300"),
+			expectError: true,
+		},
 		"invalid string": {
 			val:         tftypes.NewValue(tftypes.String, "not ok"),
 			expectError: true,
@@ -142,3 +150,55 @@ func TestDurationToStringValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationValueSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		duration    fwtypes.Duration
+		expected    int64
+		expectError bool
+	}{
+		"seconds unit": {
+			duration: fwtypes.DurationValue("300s"),
+			expected: 300,
+		},
+		"minutes unit": {
+			duration: fwtypes.DurationValue("5m"),
+			expected: 300,
+		},
+		"zero": {
+			duration: fwtypes.DurationValue("0"),
+			expected: 0,
+		},
+		"fractional seconds": {
+			duration:    fwtypes.DurationValue("1.5s"),
+			expectError: true,
+		},
+		"null": {
+			duration: fwtypes.DurationNull(),
+			expected: 0,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.duration.ValueSeconds()
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			if want := test.expected; got != want {
+				t.Errorf("ValueSeconds() = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+var (
+	JSONStringType = jsonStringType{}
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*jsonStringType)(nil)
+	_ basetypes.StringTypable                    = (*jsonStringType)(nil)
+	_ basetypes.StringValuable                   = (*JSONString)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONString)(nil)
+)
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "JSONStringType"
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONStringNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONStringUnknown(), diags
+	}
+
+	return JSONString{StringValue: in}, diags
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonStringType) ValueType(context.Context) attr.Value {
+	return JSONString{}
+}
+
+func (t jsonStringType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Given Value: "+value+"\n",
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func JSONStringNull() JSONString {
+	return JSONString{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONStringUnknown() JSONString {
+	return JSONString{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONStringValue(value string) JSONString {
+	return JSONString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// JSONString is a string value holding a JSON document.
+// Values are semantically equal if they decode to the same JSON value, so that differences
+// in whitespace or object key order do not cause spurious diffs.
+type JSONString struct {
+	basetypes.StringValue
+}
+
+func (v JSONString) Equal(o attr.Value) bool {
+	other, ok := o.(JSONString)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONString) Type(context.Context) attr.Type {
+	return JSONStringType
+}
+
+func (v JSONString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONString)
+
+	if !ok {
+		return false, diags
+	}
+
+	return jsonStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+func jsonStringsEquivalent(s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	var v1, v2 any
+
+	if err := json.Unmarshal([]byte(s1), &v1); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(s2), &v2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
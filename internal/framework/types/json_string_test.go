@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONStringTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid string": {
+			val: tftypes.NewValue(tftypes.String, `{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.JSONStringType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONStringStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONString
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   fwtypes.JSONStringValue(`{"a":1}`),
+			val2:   fwtypes.JSONStringValue(`{"a":1}`),
+			equals: true,
+		},
+		"reordered keys": {
+			val1:   fwtypes.JSONStringValue(`{"a":1,"b":{"c":[1,2],"d":"x"}}`),
+			val2:   fwtypes.JSONStringValue(`{"b":{"d":"x","c":[1,2]},"a":1}`),
+			equals: true,
+		},
+		"whitespace": {
+			val1: fwtypes.JSONStringValue(`{"a":1}`),
+			val2: fwtypes.JSONStringValue(`{
+  "a": 1
+}`),
+			equals: true,
+		},
+		"different values": {
+			val1: fwtypes.JSONStringValue(`{"a":1}`),
+			val2: fwtypes.JSONStringValue(`{"a":2}`),
+		},
+		"reordered array": {
+			val1: fwtypes.JSONStringValue(`[1,2]`),
+			val2: fwtypes.JSONStringValue(`[2,1]`),
+		},
+		"invalid": {
+			val1: fwtypes.JSONStringValue(`{"a":1}`),
+			val2: fwtypes.JSONStringValue(`not ok`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
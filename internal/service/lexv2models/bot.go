@@ -49,6 +49,9 @@ func newResourceBot(_ context.Context) (resource.ResourceWithConfigure, error) {
 
 const (
 	ResNameBot = "Bot"
+
+	// testBotAliasID is the ID of the test bot alias that Lex creates with each bot.
+	testBotAliasID = "TSTALIASID"
 )
 
 type resourceBot struct {
@@ -83,6 +86,12 @@ func (r *resourceBot) Schema(ctx context.Context, req resource.SchemaRequest, re
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"test_bot_alias_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"test_bot_alias_tags": schema.MapAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -210,6 +219,7 @@ func (r *resourceBot) Create(ctx context.Context, req resource.CreateRequest, re
 	state := plan
 	state.Type = flex.StringValueToFramework(ctx, out.BotType)
 	state.ARN = flex.StringValueToFramework(ctx, botArn)
+	state.TestBotAliasID = types.StringValue(testBotAliasID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 
@@ -259,6 +269,7 @@ func (r *resourceBot) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Type = flex.StringValueToFramework(ctx, out.BotType)
 	state.Description = flex.StringToFramework(ctx, out.Description)
 	state.IdleSessionTTLInSeconds = flex.Int32ToFramework(ctx, out.IdleSessionTTLInSeconds)
+	state.TestBotAliasID = types.StringValue(testBotAliasID)
 
 	members, errDiags := flattenMembers(ctx, out.BotMembers)
 	resp.Diagnostics.Append(errDiags...)
@@ -273,6 +284,21 @@ func (r *resourceBot) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	state.DataPrivacy = datap
+
+	testBotAliasTags, err := listTags(ctx, conn, r.testBotAliasARN(aws.ToString(out.BotId)))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, ResNameBot, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Keep unconfigured test bot alias tags null rather than an empty map.
+	if m := testBotAliasTags.IgnoreAWS().Map(); len(m) > 0 || len(state.TestBotAliasTags.Elements()) > 0 {
+		state.TestBotAliasTags = flex.FlattenFrameworkStringValueMap(ctx, m)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -289,7 +315,6 @@ func (r *resourceBot) Update(ctx context.Context, req resource.UpdateRequest, re
 	if !plan.Description.Equal(state.Description) ||
 		!plan.IdleSessionTTLInSeconds.Equal(state.IdleSessionTTLInSeconds) ||
 		!plan.RoleARN.Equal(state.RoleARN) ||
-		!plan.TestBotAliasTags.Equal(state.TestBotAliasTags) ||
 		!plan.DataPrivacy.Equal(state.DataPrivacy) ||
 		!plan.Type.Equal(state.Type) {
 		var dp []dataPrivacyData
@@ -352,6 +377,17 @@ func (r *resourceBot) Update(ctx context.Context, req resource.UpdateRequest, re
 		resp.Diagnostics.Append(plan.refreshFromOutput(ctx, out)...)
 	}
 
+	// UpdateBot doesn't accept test bot alias tags, so tag the test bot alias directly.
+	if !plan.TestBotAliasTags.Equal(state.TestBotAliasTags) {
+		if err := updateTags(ctx, conn, r.testBotAliasARN(plan.ID.ValueString()), state.TestBotAliasTags, plan.TestBotAliasTags); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBot, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -392,6 +428,17 @@ func (r *resourceBot) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+// testBotAliasARN returns the ARN of the test bot alias that Lex creates with the specified bot.
+func (r *resourceBot) testBotAliasARN(botID string) string {
+	return arn.ARN{
+		Partition: r.Meta().Partition,
+		Service:   "lex",
+		Region:    r.Meta().Region,
+		AccountID: r.Meta().AccountID,
+		Resource:  fmt.Sprintf("bot-alias/%s/%s", botID, testBotAliasID),
+	}.String()
+}
+
 func (r *resourceBot) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
@@ -472,16 +519,8 @@ func FindBotByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lex
 		BotId: aws.String(id),
 	}
 
-	out, err := conn.DescribeBot(ctx, in)
+	out, err := describe(ctx, in, conn.DescribeBot)
 	if err != nil {
-		var nfe *awstypes.ResourceNotFoundException
-		if errors.As(err, &nfe) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: in,
-			}
-		}
-
 		return nil, err
 	}
 
@@ -605,6 +644,7 @@ type resourceBotData struct {
 	RoleARN                 fwtypes.ARN    `tfsdk:"role_arn"`
 	Tags                    types.Map      `tfsdk:"tags"`
 	TagsAll                 types.Map      `tfsdk:"tags_all"`
+	TestBotAliasID          types.String   `tfsdk:"test_bot_alias_id"`
 	TestBotAliasTags        types.Map      `tfsdk:"test_bot_alias_tags"`
 	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 	Type                    types.String   `tfsdk:"type"`
@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Bot Export")
+func newResourceBotExport(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotExport{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotExport = "Bot Export"
+)
+
+type resourceBotExport struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceBotExport) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_bot_export"
+}
+
+func (r *resourceBotExport) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fileFormatType := fwtypes.StringEnumType[awstypes.ImportExportFileFormat]()
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// The pre-signed URL changes on each DescribeExport, so the URL returned on creation is retained.
+			"download_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"export_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExportStatus](),
+				Computed:   true,
+			},
+			"file_format": schema.StringAttribute{
+				CustomType: fileFormatType,
+				Optional:   true,
+				Computed:   true,
+				Default:    fileFormatType.AttributeDefault(awstypes.ImportExportFileFormatLexJson),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"resource_specification": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exportResourceSpecificationData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_export_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botExportSpecificationData](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_id": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"bot_version": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBotExport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotExportData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.CreateExportInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateExport(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotExport, "", err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.ExportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotExport, "", nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.ExportId)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	export, err := waitBotExportCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotExport, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, export, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotExport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotExportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindBotExportByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	downloadURL := state.DownloadURL
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !downloadURL.IsNull() {
+		state.DownloadURL = downloadURL
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceBotExport) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op update
+}
+
+func (r *resourceBotExport) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotExportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteExport(ctx, &lexmodelsv2.DeleteExportInput{
+		ExportId: aws.String(state.ID.ValueString()),
+	})
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitBotExportDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceBotExport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func waitBotExportCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeExportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ExportStatusInProgress),
+		Target:                    enum.Slice(awstypes.ExportStatusCompleted),
+		Refresh:                   statusBotExport(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeExportOutput); ok {
+		if out.ExportStatus == awstypes.ExportStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotExportDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeExportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ExportStatusDeleting),
+		Target:  []string{},
+		Refresh: statusBotExport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeExportOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusBotExport(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindBotExportByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.ExportStatus), nil
+	}
+}
+
+func FindBotExportByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeExportOutput, error) {
+	in := &lexmodelsv2.DescribeExportInput{
+		ExportId: aws.String(id),
+	}
+
+	out, err := describe(ctx, in, conn.DescribeExport)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ExportId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceBotExportData struct {
+	DownloadURL           types.String                                                     `tfsdk:"download_url"`
+	ExportStatus          fwtypes.StringEnum[awstypes.ExportStatus]                        `tfsdk:"export_status"`
+	FileFormat            fwtypes.StringEnum[awstypes.ImportExportFileFormat]              `tfsdk:"file_format"`
+	FilePassword          types.String                                                     `tfsdk:"file_password"`
+	ID                    types.String                                                     `tfsdk:"id"`
+	ResourceSpecification fwtypes.ListNestedObjectValueOf[exportResourceSpecificationData] `tfsdk:"resource_specification"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type exportResourceSpecificationData struct {
+	BotExportSpecification fwtypes.ListNestedObjectValueOf[botExportSpecificationData] `tfsdk:"bot_export_specification"`
+}
+
+type botExportSpecificationData struct {
+	BotID      types.String `tfsdk:"bot_id"`
+	BotVersion types.String `tfsdk:"bot_version"`
+}
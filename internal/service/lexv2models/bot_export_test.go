@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotExport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botexport lexmodelsv2.DescribeExportOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_export.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotExportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExportExists(ctx, resourceName, &botexport),
+					resource.TestCheckResourceAttrSet(resourceName, "download_url"),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "Completed"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", "LexJson"),
+					resource.TestCheckResourceAttr(resourceName, "resource_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_specification.0.bot_export_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_specification.0.bot_export_specification.0.bot_id", botResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_specification.0.bot_export_specification.0.bot_version", "DRAFT"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"download_url"},
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotExport_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var botexport lexmodelsv2.DescribeExportOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotExportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExportExists(ctx, resourceName, &botexport),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceBotExport, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotExportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_export" {
+				continue
+			}
+
+			_, err := tflexv2models.FindBotExportByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameBotExport, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotExportExists(ctx context.Context, name string, botexport *lexmodelsv2.DescribeExportOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotExport, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotExport, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindBotExportByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotExport, rs.Primary.ID, err)
+		}
+
+		*botexport = *resp
+
+		return nil
+	}
+}
+
+func testAccBotExportConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 60
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = "true"
+  }
+}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+}
+`, rName))
+}
+
+func testAccBotExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotExportConfig_base(rName),
+		`
+resource "aws_lexv2models_bot_export" "test" {
+  resource_specification {
+    bot_export_specification {
+      bot_id      = aws_lexv2models_bot.test.id
+      bot_version = "DRAFT"
+    }
+  }
+
+  depends_on = [aws_lexv2models_bot_locale.test]
+}
+`)
+}
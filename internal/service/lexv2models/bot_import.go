@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Bot Import")
+func newResourceBotImport(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotImport{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotImport = "Bot Import"
+)
+
+type resourceBotImport struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceBotImport) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_bot_import"
+}
+
+func (r *resourceBotImport) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	mergeStrategyType := fwtypes.StringEnumType[awstypes.MergeStrategy]()
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"file_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("filename"),
+						path.MatchRoot("source_url"),
+					),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"import_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ImportStatus](),
+				Computed:   true,
+			},
+			"imported_resource_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"imported_resource_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"merge_strategy": schema.StringAttribute{
+				CustomType: mergeStrategyType,
+				Optional:   true,
+				Computed:   true,
+				Default:    mergeStrategyType.AttributeDefault(awstypes.MergeStrategyFailOnConflict),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_url": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_specification": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importResourceSpecificationData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_import_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botImportSpecificationData](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_name": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"idle_session_ttl_in_seconds": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.UseStateForUnknown(),
+											int64planmodifier.RequiresReplace(),
+										},
+										Validators: []validator.Int64{
+											int64validator.Between(60, 86400),
+										},
+									},
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"data_privacy": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataPrivacyData](ctx),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"child_directed": schema.BoolAttribute{
+													Required: true,
+													PlanModifiers: []planmodifier.Bool{
+														boolplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBotImport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotImportData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archive, err := readBotImportArchive(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotImport, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	upload, err := conn.CreateUploadUrl(ctx, &lexmodelsv2.CreateUploadUrlInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotImport, "", err),
+			err.Error(),
+		)
+		return
+	}
+	if upload == nil || upload.ImportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotImport, "", nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	id := aws.ToString(upload.ImportId)
+
+	if err := uploadBotImportArchive(ctx, aws.ToString(upload.UploadUrl), archive); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotImport, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &lexmodelsv2.StartImportInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.ImportId = aws.String(id)
+
+	if _, err := conn.StartImport(ctx, in); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotImport, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitBotImportCreated(ctx, conn, id, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotImport, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotImport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotImportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindBotImportByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceBotImport) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op update
+}
+
+func (r *resourceBotImport) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotImportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting an import removes the import and its uploaded archive, not the imported bot.
+	_, err := conn.DeleteImport(ctx, &lexmodelsv2.DeleteImportInput{
+		ImportId: aws.String(state.ID.ValueString()),
+	})
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitBotImportDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceBotImport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readBotImportArchive returns the contents of the zip archive to import, read from either a local file or a URL.
+func readBotImportArchive(ctx context.Context, data resourceBotImportData) ([]byte, error) {
+	if v := data.Filename.ValueString(); v != "" {
+		return os.ReadFile(v)
+	}
+
+	url := data.SourceURL.ValueString()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET (%s): %w", url, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP GET (%s): unexpected status: %s", url, response.Status)
+	}
+
+	archive, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading response body (%s): %w", url, err)
+	}
+
+	return archive, nil
+}
+
+// uploadBotImportArchive uploads the zip archive to the pre-signed URL returned by CreateUploadUrl.
+func uploadBotImportArchive(ctx context.Context, url string, archive []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(archive))
+
+	if err != nil {
+		return err
+	}
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return fmt.Errorf("uploading import archive: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("uploading import archive: unexpected status: %s", response.Status)
+	}
+
+	return nil
+}
+
+func waitBotImportCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ImportStatusInProgress),
+		Target:                    enum.Slice(awstypes.ImportStatusCompleted),
+		Refresh:                   statusBotImport(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeImportOutput); ok {
+		if out.ImportStatus == awstypes.ImportStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotImportDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ImportStatusDeleting),
+		Target:  []string{},
+		Refresh: statusBotImport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeImportOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusBotImport(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindBotImportByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.ImportStatus), nil
+	}
+}
+
+func FindBotImportByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeImportOutput, error) {
+	in := &lexmodelsv2.DescribeImportInput{
+		ImportId: aws.String(id),
+	}
+
+	out, err := describe(ctx, in, conn.DescribeImport)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ImportId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceBotImportData struct {
+	FilePassword          types.String                                                     `tfsdk:"file_password"`
+	Filename              types.String                                                     `tfsdk:"filename"`
+	ID                    types.String                                                     `tfsdk:"id"`
+	ImportStatus          fwtypes.StringEnum[awstypes.ImportStatus]                        `tfsdk:"import_status"`
+	ImportedResourceID    types.String                                                     `tfsdk:"imported_resource_id"`
+	ImportedResourceName  types.String                                                     `tfsdk:"imported_resource_name"`
+	MergeStrategy         fwtypes.StringEnum[awstypes.MergeStrategy]                       `tfsdk:"merge_strategy"`
+	ResourceSpecification fwtypes.ListNestedObjectValueOf[importResourceSpecificationData] `tfsdk:"resource_specification"`
+	SourceURL             types.String                                                     `tfsdk:"source_url"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type importResourceSpecificationData struct {
+	BotImportSpecification fwtypes.ListNestedObjectValueOf[botImportSpecificationData] `tfsdk:"bot_import_specification"`
+}
+
+type botImportSpecificationData struct {
+	BotName                 types.String                                     `tfsdk:"bot_name"`
+	DataPrivacy             fwtypes.ListNestedObjectValueOf[dataPrivacyData] `tfsdk:"data_privacy"`
+	IdleSessionTTLInSeconds types.Int64                                      `tfsdk:"idle_session_ttl_in_seconds"`
+	RoleARN                 fwtypes.ARN                                      `tfsdk:"role_arn"`
+}
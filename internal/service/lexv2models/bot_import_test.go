@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botimport lexmodelsv2.DescribeImportOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameImported := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_import.test"
+	filename := filepath.Join(t.TempDir(), "bot.zip")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDownloadBotExport(ctx, "aws_lexv2models_bot_export.test", filename),
+				),
+			},
+			{
+				Config: testAccBotImportConfig_basic(rName, rNameImported, filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotImportExists(ctx, resourceName, &botimport),
+					resource.TestCheckResourceAttr(resourceName, "import_status", "Completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "imported_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "imported_resource_name", rNameImported),
+					resource.TestCheckResourceAttr(resourceName, "merge_strategy", "FailOnConflict"),
+					resource.TestCheckResourceAttr(resourceName, "resource_specification.0.bot_import_specification.0.bot_name", rNameImported),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename"},
+			},
+		},
+	})
+}
+
+func testAccCheckBotImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_import" {
+				continue
+			}
+
+			_, err := tflexv2models.FindBotImportByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameBotImport, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotImportExists(ctx context.Context, name string, botimport *lexmodelsv2.DescribeImportOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotImport, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotImport, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindBotImportByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotImport, rs.Primary.ID, err)
+		}
+
+		*botimport = *resp
+
+		return nil
+	}
+}
+
+// testAccDownloadBotExport downloads the archive of the specified export to a local file.
+// The export's download URL expires shortly after creation, so the archive is downloaded straight away.
+func testAccDownloadBotExport(ctx context.Context, name, filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, rs.Primary.Attributes["download_url"], nil)
+		if err != nil {
+			return err
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("downloading Lex V2 Models Bot Export (%s): unexpected status: %s", rs.Primary.ID, response.Status)
+		}
+
+		archive, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(filename, archive, 0600)
+	}
+}
+
+func testAccBotImportConfig_basic(rName, rNameImported, filename string) string {
+	return acctest.ConfigCompose(
+		testAccBotExportConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot_import" "test" {
+  filename = %[2]q
+
+  resource_specification {
+    bot_import_specification {
+      bot_name                    = %[1]q
+      idle_session_ttl_in_seconds = 60
+      role_arn                    = aws_iam_role.test.arn
+
+      data_privacy {
+        child_directed = true
+      }
+    }
+  }
+}
+`, rNameImported, filename))
+}
@@ -13,8 +13,6 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +26,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -98,7 +97,41 @@ func (r *resourceBotLocale) Schema(ctx context.Context, req resource.SchemaReque
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"generative_ai_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAISettingsData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"buildtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[buildtimeSettingsData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"descriptive_bot_builder":     generativeAIFeatureSpecificationBlock(ctx),
+									"sample_utterance_generation": generativeAIFeatureSpecificationBlock(ctx),
+								},
+							},
+						},
+						"runtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[runtimeSettingsData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"slot_resolution_improvement": generativeAIFeatureSpecificationBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
 			"voice_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[voiceSettingsData](ctx),
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
@@ -108,11 +141,9 @@ func (r *resourceBotLocale) Schema(ctx context.Context, req resource.SchemaReque
 							Required: true,
 						},
 						"engine": schema.StringAttribute{
-							Optional: true,
-							Computed: true,
-							Validators: []validator.String{
-								enum.FrameworkValidate[awstypes.VoiceEngine](),
-							},
+							CustomType: fwtypes.StringEnumType[awstypes.VoiceEngine](),
+							Optional:   true,
+							Computed:   true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
@@ -129,6 +160,40 @@ func (r *resourceBotLocale) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+// generativeAIFeatureSpecificationBlock returns the schema for a generative AI feature,
+// e.g. sample utterance generation, and the Amazon Bedrock model that it uses.
+func generativeAIFeatureSpecificationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAIFeatureSpecificationData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"bedrock_model_specification": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[bedrockModelSpecificationData](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"model_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 const (
 	botLocaleIDPartCount = 3
 )
@@ -142,25 +207,10 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	in := &lexmodelsv2.CreateBotLocaleInput{
-		BotId:                        aws.String(plan.BotID.ValueString()),
-		BotVersion:                   aws.String(plan.BotVersion.ValueString()),
-		LocaleId:                     aws.String(plan.LocaleID.ValueString()),
-		NluIntentConfidenceThreshold: aws.Float64(plan.NluIntentCOnfidenceThreshold.ValueFloat64()),
-	}
-
-	if !plan.Description.IsNull() {
-		in.Description = aws.String(plan.Description.ValueString())
-	}
-	if !plan.VoiceSettings.IsNull() {
-		var tfList []voiceSettingsData
-		resp.Diagnostics.Append(plan.VoiceSettings.ElementsAs(ctx, &tfList, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		vsInput := expandVoiceSettings(ctx, tfList)
-		in.VoiceSettings = vsInput
+	in := &lexmodelsv2.CreateBotLocaleInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	out, err := conn.CreateBotLocale(ctx, in)
@@ -189,23 +239,13 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	plan.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
 	plan.Id = types.StringValue(id)
 	state := plan
-	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
-	state.BotID = flex.StringToFramework(ctx, out.BotId)
-	state.Id = types.StringValue(id)
-	state.Description = flex.StringToFramework(ctx, out.Description)
-	state.Name = flex.StringToFramework(ctx, out.LocaleName)
-
-	vs, _ := flattenVoiceSettings(ctx, out.VoiceSettings)
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.VoiceSettings = vs
-
-	state.BotVersion = flex.StringValueToFramework(ctx, *out.BotVersion)
-	state.NluIntentCOnfidenceThreshold = flex.Float64ToFramework(ctx, out.NluIntentConfidenceThreshold)
+	state.Name = flex.StringToFramework(ctx, out.LocaleName)
 
 	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
 	_, err = waitBotLocaleCreated(ctx, conn, state.Id.ValueString(), createTimeout)
@@ -241,19 +281,12 @@ func (r *resourceBotLocale) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
-	state.BotID = flex.StringToFramework(ctx, out.BotId)
-	state.Description = flex.StringToFramework(ctx, out.Description)
-	state.BotVersion = flex.StringValueToFramework(ctx, *out.BotVersion)
-	state.Name = flex.StringToFramework(ctx, out.LocaleName)
-	state.NluIntentCOnfidenceThreshold = flex.Float64ToFramework(ctx, out.NluIntentConfidenceThreshold)
-
-	vs, _ := flattenVoiceSettings(ctx, out.VoiceSettings)
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Name = flex.StringToFramework(ctx, out.LocaleName)
 
-	state.VoiceSettings = vs
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -267,31 +300,14 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	if !plan.BotID.Equal(state.BotID) ||
-		!plan.Description.Equal(state.Description) ||
-		!plan.BotVersion.Equal(state.BotVersion) ||
-		!plan.LocaleID.Equal(state.LocaleID) ||
-		!plan.Name.Equal(state.Name) ||
+	if !plan.Description.Equal(state.Description) ||
+		!plan.GenerativeAISettings.Equal(state.GenerativeAISettings) ||
 		!plan.VoiceSettings.Equal(state.VoiceSettings) ||
-		!plan.NluIntentCOnfidenceThreshold.Equal(state.NluIntentCOnfidenceThreshold) {
-		in := &lexmodelsv2.UpdateBotLocaleInput{
-			BotId:                        aws.String(plan.BotID.ValueString()),
-			BotVersion:                   aws.String(plan.BotVersion.ValueString()),
-			LocaleId:                     aws.String(plan.LocaleID.ValueString()),
-			NluIntentConfidenceThreshold: aws.Float64(plan.NluIntentCOnfidenceThreshold.ValueFloat64()),
-		}
-
-		if !plan.Description.IsNull() {
-			in.Description = aws.String(plan.Description.ValueString())
-		}
-		if !plan.VoiceSettings.IsNull() {
-			var tfList []voiceSettingsData
-			resp.Diagnostics.Append(plan.VoiceSettings.ElementsAs(ctx, &tfList, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-
-			in.VoiceSettings = expandVoiceSettings(ctx, tfList)
+		!plan.NluIntentConfidenceThreshold.Equal(state.NluIntentConfidenceThreshold) {
+		in := &lexmodelsv2.UpdateBotLocaleInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
 		_, err := conn.UpdateBotLocale(ctx, in)
@@ -319,7 +335,12 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 			)
 			return
 		}
-		state.refreshFromOutput(ctx, out)
+
+		resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Name = flex.StringToFramework(ctx, out.LocaleName)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -446,16 +467,8 @@ func FindBotLocaleByID(ctx context.Context, conn *lexmodelsv2.Client, id string)
 		BotVersion: aws.String(parts[2]),
 	}
 
-	out, err := conn.DescribeBotLocale(ctx, in)
+	out, err := describe(ctx, in, conn.DescribeBotLocale)
 	if err != nil {
-		var nfe *awstypes.ResourceNotFoundException
-		if errors.As(err, &nfe) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: in,
-			}
-		}
-
 		return nil, err
 	}
 
@@ -466,78 +479,43 @@ func FindBotLocaleByID(ctx context.Context, conn *lexmodelsv2.Client, id string)
 	return out, nil
 }
 
-func flattenVoiceSettings(ctx context.Context, apiObject *awstypes.VoiceSettings) (types.List, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	elemType := types.ObjectType{AttrTypes: voiceSettingsAttrTypes}
-
-	if apiObject == nil {
-		return types.ListValueMust(elemType, []attr.Value{}), diags
-	}
-
-	obj := map[string]attr.Value{
-		"voice_id": flex.StringValueToFramework(ctx, *apiObject.VoiceId),
-		"engine":   flex.StringValueToFramework(ctx, apiObject.Engine),
-	}
-	objVal, d := types.ObjectValue(voiceSettingsAttrTypes, obj)
-	diags.Append(d...)
-
-	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
-	diags.Append(d...)
-
-	return listVal, diags
+type resourceBotLocaleData struct {
+	BotID                        types.String                                              `tfsdk:"bot_id"`
+	BotVersion                   types.String                                              `tfsdk:"bot_version"`
+	LocaleID                     types.String                                              `tfsdk:"locale_id"`
+	Name                         types.String                                              `tfsdk:"name"`
+	GenerativeAISettings         fwtypes.ListNestedObjectValueOf[generativeAISettingsData] `tfsdk:"generative_ai_settings"`
+	VoiceSettings                fwtypes.ListNestedObjectValueOf[voiceSettingsData]        `tfsdk:"voice_settings"`
+	Description                  types.String                                              `tfsdk:"description"`
+	NluIntentConfidenceThreshold types.Float64                                             `tfsdk:"n_lu_intent_confidence_threshold"`
+	Id                           types.String                                              `tfsdk:"id"`
+	Timeouts                     timeouts.Value                                            `tfsdk:"timeouts"`
 }
 
-func expandVoiceSettings(ctx context.Context, tfList []voiceSettingsData) *awstypes.VoiceSettings {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	tfObj := tfList[0]
-	return &awstypes.VoiceSettings{
-		VoiceId: flex.StringFromFramework(ctx, tfObj.VoiceId),
-		Engine:  awstypes.VoiceEngine(tfObj.Engine.ValueString()),
-	}
+type generativeAISettingsData struct {
+	BuildtimeSettings fwtypes.ListNestedObjectValueOf[buildtimeSettingsData] `tfsdk:"buildtime_settings"`
+	RuntimeSettings   fwtypes.ListNestedObjectValueOf[runtimeSettingsData]   `tfsdk:"runtime_settings"`
 }
 
-type resourceBotLocaleData struct {
-	BotID                        types.String   `tfsdk:"bot_id"`
-	BotVersion                   types.String   `tfsdk:"bot_version"`
-	LocaleID                     types.String   `tfsdk:"locale_id"`
-	Name                         types.String   `tfsdk:"name"`
-	VoiceSettings                types.List     `tfsdk:"voice_settings"`
-	Description                  types.String   `tfsdk:"description"`
-	NluIntentCOnfidenceThreshold types.Float64  `tfsdk:"n_lu_intent_confidence_threshold"`
-	Id                           types.String   `tfsdk:"id"`
-	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+type buildtimeSettingsData struct {
+	DescriptiveBotBuilder     fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationData] `tfsdk:"descriptive_bot_builder"`
+	SampleUtteranceGeneration fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationData] `tfsdk:"sample_utterance_generation"`
 }
 
-type voiceSettingsData struct {
-	VoiceId types.String `tfsdk:"voice_id"`
-	Engine  types.String `tfsdk:"engine"`
+type runtimeSettingsData struct {
+	SlotResolutionImprovement fwtypes.ListNestedObjectValueOf[generativeAIFeatureSpecificationData] `tfsdk:"slot_resolution_improvement"`
 }
 
-var voiceSettingsAttrTypes = map[string]attr.Type{
-	"voice_id": types.StringType,
-	"engine":   types.StringType,
+type generativeAIFeatureSpecificationData struct {
+	BedrockModelSpecification fwtypes.ListNestedObjectValueOf[bedrockModelSpecificationData] `tfsdk:"bedrock_model_specification"`
+	Enabled                   types.Bool                                                     `tfsdk:"enabled"`
 }
 
-// refreshFromOutput writes state data from an AWS response object
-func (rd *resourceBotLocaleData) refreshFromOutput(ctx context.Context, out *lexmodelsv2.DescribeBotLocaleOutput) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	if out == nil {
-		return diags
-	}
+type bedrockModelSpecificationData struct {
+	ModelARN fwtypes.ARN `tfsdk:"model_arn"`
+}
 
-	rd.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
-	rd.BotID = flex.StringToFramework(ctx, out.BotId)
-	rd.Description = flex.StringToFramework(ctx, out.Description)
-	vs, d := flattenVoiceSettings(ctx, out.VoiceSettings)
-	diags.Append(d...)
-	rd.VoiceSettings = vs
-	rd.BotVersion = flex.StringValueToFramework(ctx, *out.BotVersion)
-	rd.Name = flex.StringToFramework(ctx, out.LocaleName)
-	rd.NluIntentCOnfidenceThreshold = flex.Float64ToFramework(ctx, out.NluIntentConfidenceThreshold)
-
-	return diags
+type voiceSettingsData struct {
+	VoiceID types.String                             `tfsdk:"voice_id"`
+	Engine  fwtypes.StringEnum[awstypes.VoiceEngine] `tfsdk:"engine"`
 }
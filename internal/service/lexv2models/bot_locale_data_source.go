@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Bot Locale")
+func newDataSourceBotLocale(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBotLocale{}, nil
+}
+
+const (
+	DSNameBotLocale = "Bot Locale Data Source"
+)
+
+type dataSourceBotLocale struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBotLocale) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lexv2models_bot_locale"
+}
+
+func (d *dataSourceBotLocale) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+			},
+			"bot_locale_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": framework.IDAttribute(),
+			"locale_id": schema.StringAttribute{
+				Required: true,
+			},
+			"n_lu_intent_confidence_threshold": schema.Float64Attribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"voice_settings": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[voiceSettingsData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[voiceSettingsData](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceBotLocale) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().LexV2ModelsClient(ctx)
+
+	var data dataSourceBotLocaleData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := fwflex.FlattenResourceId([]string{data.LocaleID.ValueString(), data.BotID.ValueString(), data.BotVersion.ValueString()}, botLocaleIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBotLocale, data.LocaleID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := FindBotLocaleByID(ctx, conn, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBotLocale, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.Name = flex.StringToFramework(ctx, out.LocaleName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceBotLocaleData struct {
+	BotID                        types.String                                       `tfsdk:"bot_id"`
+	BotLocaleStatus              types.String                                       `tfsdk:"bot_locale_status"`
+	BotVersion                   types.String                                       `tfsdk:"bot_version"`
+	Description                  types.String                                       `tfsdk:"description"`
+	ID                           types.String                                       `tfsdk:"id"`
+	LocaleID                     types.String                                       `tfsdk:"locale_id"`
+	Name                         types.String                                       `tfsdk:"name"`
+	NluIntentConfidenceThreshold types.Float64                                      `tfsdk:"n_lu_intent_confidence_threshold"`
+	VoiceSettings                fwtypes.ListNestedObjectValueOf[voiceSettingsData] `tfsdk:"voice_settings"`
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotLocaleDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lexv2models_bot_locale.test"
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_id", resourceName, "bot_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_version", resourceName, "bot_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "locale_id", resourceName, "locale_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "n_lu_intent_confidence_threshold", resourceName, "n_lu_intent_confidence_threshold"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bot_locale_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "voice_settings.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "voice_settings.0.voice_id", resourceName, "voice_settings.0.voice_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "voice_settings.0.engine", string(types.VoiceEngineStandard)),
+				),
+			},
+		},
+	})
+}
+
+func testAccBotLocaleDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_voiceSettings(rName, "Kendra", string(types.VoiceEngineStandard)),
+		`
+data "aws_lexv2models_bot_locale" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+}
+`)
+}
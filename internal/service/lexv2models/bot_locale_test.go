@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -122,6 +123,117 @@ func TestAccLexV2ModelsBotLocale_voiceSettings(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccBotLocaleConfig_voiceSettings(rName, voiceID, string(types.VoiceEngineNeural)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "voice_settings.*", map[string]string{
+						"voice_id": voiceID,
+						"engine":   string(types.VoiceEngineNeural),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotLocale_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botlocale lexmodelsv2.DescribeBotLocaleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_description(rName, "description1", 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "n_lu_intent_confidence_threshold", "0.7"),
+				),
+			},
+			{
+				Config: testAccBotLocaleConfig_description(rName, "description2", 0.8),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "n_lu_intent_confidence_threshold", "0.8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotLocale_generativeAISettings(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botlocale lexmodelsv2.DescribeBotLocaleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.#", "0"),
+				),
+			},
+			{
+				Config: testAccBotLocaleConfig_generativeAISettings(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.sample_utterance_generation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.sample_utterance_generation.0.enabled", "true"),
+					acctest.CheckResourceAttrRegionalARNNoAccount(resourceName, "generative_ai_settings.0.buildtime_settings.0.sample_utterance_generation.0.bedrock_model_specification.0.model_arn", "bedrock", "foundation-model/anthropic.claude-v2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -219,3 +331,61 @@ resource "aws_lexv2models_bot_locale" "test" {
 }
 `, voiceID, engine))
 }
+
+func testAccBotLocaleConfig_description(rName, description string, thres float64) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  description                      = %[1]q
+  n_lu_intent_confidence_threshold = %[2]g
+}
+`, description, thres))
+}
+
+func testAccBotLocaleConfig_generativeAISettings(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfigBase(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = "bedrock:InvokeModel"
+        Effect   = "Allow"
+        Resource = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+      },
+    ]
+  })
+}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+
+  generative_ai_settings {
+    buildtime_settings {
+      sample_utterance_generation {
+        enabled = true
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -7,16 +7,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex/flextest"
 	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -48,6 +52,7 @@ func TestAccLexV2ModelsBot_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "60"),
 					resource.TestCheckResourceAttrPair(resourceName, "role_arn", iamRoleResourceName, "arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "data_privacy.0.child_directed"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_id", "TSTALIASID"),
 				),
 			},
 			{
@@ -69,6 +74,7 @@ func TestAccLexV2ModelsBot_tags(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -111,6 +117,112 @@ func TestAccLexV2ModelsBot_tags(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsBot_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var bot1, bot2 lexmodelsv2.DescribeBotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotConfig_description(rName, 60, true, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(ctx, resourceName, &bot1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotConfig_description(rName, 60, true, "description2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(ctx, resourceName, &bot2),
+					testAccCheckBotNotRecreated(&bot1, &bot2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBot_testBotAliasTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var bot1, bot2 lexmodelsv2.DescribeBotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotConfig_testBotAliasTags1(rName, 60, true, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(ctx, resourceName, &bot1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.botKey", "botValue"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotConfig_testBotAliasTags2(rName, 60, true, "key1", "value1updated", "key2", "value2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(ctx, resourceName, &bot2),
+					testAccCheckBotNotRecreated(&bot1, &bot2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.botKey", "botValue"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBotConfig_testBotAliasTags1(rName, 60, true, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(ctx, resourceName, &bot2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLexV2ModelsBot_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -125,6 +237,7 @@ func TestAccLexV2ModelsBot_disappears(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -176,6 +289,17 @@ func TestAccLexV2ModelsBot_type(t *testing.T) {
 	})
 }
 
+func TestStringEnumRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BotType", flextest.StringEnumRoundTrip[types.BotType])
+	t.Run("ExportStatus", flextest.StringEnumRoundTrip[types.ExportStatus])
+	t.Run("ImportExportFileFormat", flextest.StringEnumRoundTrip[types.ImportExportFileFormat])
+	t.Run("ImportStatus", flextest.StringEnumRoundTrip[types.ImportStatus])
+	t.Run("MergeStrategy", flextest.StringEnumRoundTrip[types.MergeStrategy])
+	t.Run("VoiceEngine", flextest.StringEnumRoundTrip[types.VoiceEngine])
+}
+
 func testAccCheckBotDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -224,6 +348,17 @@ func testAccCheckBotExists(ctx context.Context, name string, bot *lexmodelsv2.De
 	}
 }
 
+// testAccPreCheck skips the test if Lex V2 Models is not available in the configured Region.
+func testAccCheckBotNotRecreated(before, after *lexmodelsv2.DescribeBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreationDateTime), aws.ToTime(after.CreationDateTime); !before.Equal(after) {
+			return fmt.Errorf("Lex V2 Models Bot recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
 
@@ -233,6 +368,10 @@ func testAccPreCheck(ctx context.Context, t *testing.T) {
 	if acctest.PreCheckSkipError(err) {
 		t.Skipf("skipping acceptance testing: %s", err)
 	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		t.Skipf("skipping acceptance testing: Lex V2 Models is not available in %s: %s", acctest.Region(), err)
+	}
 	if err != nil {
 		t.Fatalf("unexpected PreCheck error: %s", err)
 	}
@@ -323,6 +462,72 @@ resource "aws_lexv2models_bot" "test" {
 `, rName, ttl, dp, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccBotConfig_description(rName string, ttl int, dp bool, description string) string {
+	return acctest.ConfigCompose(
+		testAccBotBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = %[2]d
+  role_arn                    = aws_iam_role.test.arn
+  description                 = %[4]q
+
+  data_privacy {
+    child_directed = %[3]t
+  }
+}
+`, rName, ttl, dp, description))
+}
+
+func testAccBotConfig_testBotAliasTags1(rName string, ttl int, dp bool, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccBotBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = %[2]d
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = %[3]t
+  }
+
+  tags = {
+    botKey = "botValue"
+  }
+
+  test_bot_alias_tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, ttl, dp, tagKey1, tagValue1))
+}
+
+func testAccBotConfig_testBotAliasTags2(rName string, ttl int, dp bool, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccBotBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = %[2]d
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = %[3]t
+  }
+
+  tags = {
+    botKey = "botValue"
+  }
+
+  test_bot_alias_tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, ttl, dp, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
 func testAccBotConfig_type(rName string, ttl int, dp bool, botType string) string {
 	return acctest.ConfigCompose(
 		testAccBotBaseConfig(rName),
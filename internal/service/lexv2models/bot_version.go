@@ -304,16 +304,8 @@ func FindBotVersionByID(ctx context.Context, conn *lexmodelsv2.Client, id string
 		BotVersion: aws.String(parts[1]),
 	}
 
-	out, err := conn.DescribeBotVersion(ctx, in)
+	out, err := describe(ctx, in, conn.DescribeBotVersion)
 	if err != nil {
-		var nfe *awstypes.ResourceNotFoundException
-		if errors.As(err, &nfe) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: in,
-			}
-		}
-
 		return nil, err
 	}
 
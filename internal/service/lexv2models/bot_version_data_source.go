@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Bot Version")
+func newDataSourceBotVersion(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBotVersion{}, nil
+}
+
+const (
+	DSNameBotVersion = "Bot Version Data Source"
+)
+
+type dataSourceBotVersion struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBotVersion) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lexv2models_bot_version"
+}
+
+func (d *dataSourceBotVersion) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+			},
+			"bot_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": framework.IDAttribute(),
+			"locale_specification": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.String](ctx),
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceBotVersion) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().LexV2ModelsClient(ctx)
+
+	var data dataSourceBotVersionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := fwflex.FlattenResourceId([]string{data.BotID.ValueString(), data.BotVersion.ValueString()}, botVersionIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBotVersion, data.BotID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := FindBotVersionByID(ctx, conn, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBotVersion, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	// DescribeBotVersion does not return the version's locales, so list them with their status.
+	localeStatuses := make(map[string]basetypes.StringValue)
+	pages := lexmodelsv2.NewListBotLocalesPaginator(conn, &lexmodelsv2.ListBotLocalesInput{
+		BotId:      out.BotId,
+		BotVersion: out.BotVersion,
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBotVersion, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		for _, v := range page.BotLocaleSummaries {
+			localeStatuses[aws.ToString(v.LocaleId)] = types.StringValue(string(v.BotLocaleStatus))
+		}
+	}
+
+	data.LocaleSpecification = fwtypes.NewMapValueOf(ctx, localeStatuses)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceBotVersionData struct {
+	BotID               types.String                     `tfsdk:"bot_id"`
+	BotStatus           types.String                     `tfsdk:"bot_status"`
+	BotVersion          types.String                     `tfsdk:"bot_version"`
+	CreationDateTime    fwtypes.Timestamp                `tfsdk:"creation_date_time"`
+	Description         types.String                     `tfsdk:"description"`
+	ID                  types.String                     `tfsdk:"id"`
+	LocaleSpecification fwtypes.MapValueOf[types.String] `tfsdk:"locale_specification"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lexv2models_bot_version.test"
+	resourceName := "aws_lexv2models_bot_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_id", resourceName, "bot_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_version", resourceName, "bot_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "bot_status", "Available"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "locale_specification.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "locale_specification.en_US", "Built"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBotVersionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotVersionConfig_basic(rName),
+		`
+data "aws_lexv2models_bot_version" "test" {
+  bot_id      = aws_lexv2models_bot_version.test.bot_id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+}
+`)
+}
@@ -70,6 +70,7 @@ func TestAccLexV2ModelsBotVersion_disappears(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Vocabulary")
+func newResourceCustomVocabulary(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCustomVocabulary{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameCustomVocabulary = "Custom Vocabulary"
+)
+
+type resourceCustomVocabulary struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceCustomVocabulary) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_custom_vocabulary"
+}
+
+func (r *resourceCustomVocabulary) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"locale_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"custom_vocabulary_items": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[customVocabularyItemData](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"display_as": schema.StringAttribute{
+							Optional: true,
+						},
+						"phrase": schema.StringAttribute{
+							Required: true,
+						},
+						"weight": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	customVocabularyIDPartCount = 3
+
+	// customVocabularyItemsBatchSize is the maximum number of items in a single batch create, update or delete request.
+	customVocabularyItemsBatchSize = 500
+)
+
+func (r *resourceCustomVocabulary) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.LocaleID.ValueString(),
+		plan.BotID.ValueString(),
+		plan.BotVersion.ValueString(),
+	}
+	id, err := fwflex.FlattenResourceId(idParts, customVocabularyIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameCustomVocabulary, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var items []awstypes.NewCustomVocabularyItem
+	resp.Diagnostics.Append(flex.Expand(ctx, plan.CustomVocabularyItems, &items)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := createCustomVocabularyItems(ctx, conn, idParts[1], idParts[2], idParts[0], items); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameCustomVocabulary, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	_, err = waitCustomVocabularyCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameCustomVocabulary, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceCustomVocabulary) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindCustomVocabularyByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.BotID = flex.StringToFramework(ctx, out.BotId)
+	state.BotVersion = flex.StringToFramework(ctx, out.BotVersion)
+	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
+
+	items, err := findCustomVocabularyItems(ctx, conn, aws.ToString(out.BotId), aws.ToString(out.BotVersion), aws.ToString(out.LocaleId))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, items, &state.CustomVocabularyItems)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceCustomVocabulary) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan, state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CustomVocabularyItems.Equal(state.CustomVocabularyItems) {
+		botID, botVersion, localeID := plan.BotID.ValueString(), plan.BotVersion.ValueString(), plan.LocaleID.ValueString()
+
+		var items []awstypes.NewCustomVocabularyItem
+		resp.Diagnostics.Append(flex.Expand(ctx, plan.CustomVocabularyItems, &items)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, err := findCustomVocabularyItems(ctx, conn, botID, botVersion, localeID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		add, update, del := customVocabularyItemChanges(current, items)
+
+		if err := deleteCustomVocabularyItems(ctx, conn, botID, botVersion, localeID, del); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if err := updateCustomVocabularyItems(ctx, conn, botID, botVersion, localeID, update); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if err := createCustomVocabularyItems(ctx, conn, botID, botVersion, localeID, add); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		_, err = waitCustomVocabularyUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceCustomVocabulary) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteCustomVocabularyInput{
+		BotId:      aws.String(state.BotID.ValueString()),
+		BotVersion: aws.String(state.BotVersion.ValueString()),
+		LocaleId:   aws.String(state.LocaleID.ValueString()),
+	}
+
+	_, err := conn.DeleteCustomVocabulary(ctx, in)
+	if err != nil {
+		var nfe *awstypes.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitCustomVocabularyDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceCustomVocabulary) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func createCustomVocabularyItems(ctx context.Context, conn *lexmodelsv2.Client, botID, botVersion, localeID string, items []awstypes.NewCustomVocabularyItem) error {
+	for _, chunk := range tfslices.Chunks(items, customVocabularyItemsBatchSize) {
+		out, err := conn.BatchCreateCustomVocabularyItem(ctx, &lexmodelsv2.BatchCreateCustomVocabularyItemInput{
+			BotId:                    aws.String(botID),
+			BotVersion:               aws.String(botVersion),
+			CustomVocabularyItemList: chunk,
+			LocaleId:                 aws.String(localeID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if err := failedCustomVocabularyItemsError(out.Errors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateCustomVocabularyItems(ctx context.Context, conn *lexmodelsv2.Client, botID, botVersion, localeID string, items []awstypes.CustomVocabularyItem) error {
+	for _, chunk := range tfslices.Chunks(items, customVocabularyItemsBatchSize) {
+		out, err := conn.BatchUpdateCustomVocabularyItem(ctx, &lexmodelsv2.BatchUpdateCustomVocabularyItemInput{
+			BotId:                    aws.String(botID),
+			BotVersion:               aws.String(botVersion),
+			CustomVocabularyItemList: chunk,
+			LocaleId:                 aws.String(localeID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if err := failedCustomVocabularyItemsError(out.Errors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteCustomVocabularyItems(ctx context.Context, conn *lexmodelsv2.Client, botID, botVersion, localeID string, items []awstypes.CustomVocabularyEntryId) error {
+	for _, chunk := range tfslices.Chunks(items, customVocabularyItemsBatchSize) {
+		out, err := conn.BatchDeleteCustomVocabularyItem(ctx, &lexmodelsv2.BatchDeleteCustomVocabularyItemInput{
+			BotId:                    aws.String(botID),
+			BotVersion:               aws.String(botVersion),
+			CustomVocabularyItemList: chunk,
+			LocaleId:                 aws.String(localeID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if err := failedCustomVocabularyItemsError(out.Errors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func failedCustomVocabularyItemsError(apiObjects []awstypes.FailedCustomVocabularyItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("item (%s): %s: %s", aws.ToString(apiObject.ItemId), apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// customVocabularyItemChanges returns the items to create, update and delete so that the current
+// custom vocabulary items match the planned items. Items are matched on their phrase.
+func customVocabularyItemChanges(current []awstypes.CustomVocabularyItem, planned []awstypes.NewCustomVocabularyItem) ([]awstypes.NewCustomVocabularyItem, []awstypes.CustomVocabularyItem, []awstypes.CustomVocabularyEntryId) {
+	var add []awstypes.NewCustomVocabularyItem
+	var update []awstypes.CustomVocabularyItem
+	var del []awstypes.CustomVocabularyEntryId
+
+	byPhrase := make(map[string]awstypes.CustomVocabularyItem, len(current))
+	for _, v := range current {
+		byPhrase[aws.ToString(v.Phrase)] = v
+	}
+
+	for _, v := range planned {
+		phrase := aws.ToString(v.Phrase)
+		old, ok := byPhrase[phrase]
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+		delete(byPhrase, phrase)
+
+		if aws.ToString(old.DisplayAs) != aws.ToString(v.DisplayAs) || aws.ToInt32(old.Weight) != aws.ToInt32(v.Weight) {
+			update = append(update, awstypes.CustomVocabularyItem{
+				DisplayAs: v.DisplayAs,
+				ItemId:    old.ItemId,
+				Phrase:    v.Phrase,
+				Weight:    v.Weight,
+			})
+		}
+	}
+
+	for _, v := range current {
+		if _, ok := byPhrase[aws.ToString(v.Phrase)]; ok {
+			del = append(del, awstypes.CustomVocabularyEntryId{
+				ItemId: v.ItemId,
+			})
+		}
+	}
+
+	return add, update, del
+}
+
+func waitCustomVocabularyCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.CustomVocabularyStatusCreating, awstypes.CustomVocabularyStatusImporting),
+		Target:                    enum.Slice(awstypes.CustomVocabularyStatusReady),
+		Refresh:                   statusCustomVocabulary(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitCustomVocabularyUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.CustomVocabularyStatusCreating, awstypes.CustomVocabularyStatusImporting),
+		Target:                    enum.Slice(awstypes.CustomVocabularyStatusReady),
+		Refresh:                   statusCustomVocabulary(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitCustomVocabularyDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CustomVocabularyStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCustomVocabulary(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusCustomVocabulary(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindCustomVocabularyByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.CustomVocabularyStatus), nil
+	}
+}
+
+func FindCustomVocabularyByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	parts, err := fwflex.ExpandResourceId(id, customVocabularyIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+	in := &lexmodelsv2.DescribeCustomVocabularyMetadataInput{
+		LocaleId:   aws.String(parts[0]),
+		BotId:      aws.String(parts[1]),
+		BotVersion: aws.String(parts[2]),
+	}
+
+	out, err := describe(ctx, in, conn.DescribeCustomVocabularyMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.LocaleId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findCustomVocabularyItems(ctx context.Context, conn *lexmodelsv2.Client, botID, botVersion, localeID string) ([]awstypes.CustomVocabularyItem, error) {
+	in := &lexmodelsv2.ListCustomVocabularyItemsInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+	var out []awstypes.CustomVocabularyItem
+
+	pages := lexmodelsv2.NewListCustomVocabularyItemsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.CustomVocabularyItems...)
+	}
+
+	return out, nil
+}
+
+type resourceCustomVocabularyData struct {
+	BotID                 types.String                                             `tfsdk:"bot_id"`
+	BotVersion            types.String                                             `tfsdk:"bot_version"`
+	CustomVocabularyItems fwtypes.SetNestedObjectValueOf[customVocabularyItemData] `tfsdk:"custom_vocabulary_items"`
+	ID                    types.String                                             `tfsdk:"id"`
+	LocaleID              types.String                                             `tfsdk:"locale_id"`
+	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type customVocabularyItemData struct {
+	DisplayAs types.String `tfsdk:"display_as"`
+	Phrase    types.String `tfsdk:"phrase"`
+	Weight    types.Int64  `tfsdk:"weight"`
+}
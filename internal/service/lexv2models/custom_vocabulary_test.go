@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCustomVocabularyItemsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	apiObjects := []types.CustomVocabularyItem{
+		{
+			DisplayAs: aws.String("Terraform"),
+			ItemId:    aws.String("1"),
+			Phrase:    aws.String("terraform"),
+			Weight:    aws.Int32(3),
+		},
+		{
+			ItemId: aws.String("2"),
+			Phrase: aws.String("hashicorp"),
+		},
+	}
+
+	var tfObject fwtypes.SetNestedObjectValueOf[tflexv2models.CustomVocabularyItemData]
+	if diags := flex.Flatten(ctx, apiObjects, &tfObject); diags.HasError() {
+		t.Fatalf("flattening: %v", diags)
+	}
+
+	var got []types.NewCustomVocabularyItem
+	if diags := flex.Expand(ctx, tfObject, &got); diags.HasError() {
+		t.Fatalf("expanding: %v", diags)
+	}
+
+	want := []types.NewCustomVocabularyItem{
+		{
+			DisplayAs: aws.String("Terraform"),
+			Phrase:    aws.String("terraform"),
+			Weight:    aws.Int32(3),
+		},
+		{
+			Phrase: aws.String("hashicorp"),
+		},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreUnexported(types.NewCustomVocabularyItem{}), cmpopts.SortSlices(func(a, b types.NewCustomVocabularyItem) bool {
+		return aws.ToString(a.Phrase) < aws.ToString(b.Phrase)
+	})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCustomVocabularyItemChanges(t *testing.T) {
+	t.Parallel()
+
+	current := []types.CustomVocabularyItem{
+		{ItemId: aws.String("1"), Phrase: aws.String("unchanged"), Weight: aws.Int32(1)},
+		{ItemId: aws.String("2"), Phrase: aws.String("updated"), Weight: aws.Int32(1)},
+		{ItemId: aws.String("3"), Phrase: aws.String("removed")},
+	}
+	planned := []types.NewCustomVocabularyItem{
+		{Phrase: aws.String("unchanged"), Weight: aws.Int32(1)},
+		{Phrase: aws.String("updated"), Weight: aws.Int32(2), DisplayAs: aws.String("Updated")},
+		{Phrase: aws.String("added")},
+	}
+
+	add, update, del := tflexv2models.CustomVocabularyItemChanges(current, planned)
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreUnexported(types.NewCustomVocabularyItem{}, types.CustomVocabularyItem{}, types.CustomVocabularyEntryId{}),
+	}
+	if diff := cmp.Diff(add, []types.NewCustomVocabularyItem{
+		{Phrase: aws.String("added")},
+	}, opts...); diff != "" {
+		t.Errorf("unexpected add diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(update, []types.CustomVocabularyItem{
+		{ItemId: aws.String("2"), Phrase: aws.String("updated"), Weight: aws.Int32(2), DisplayAs: aws.String("Updated")},
+	}, opts...); diff != "" {
+		t.Errorf("unexpected update diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(del, []types.CustomVocabularyEntryId{
+		{ItemId: aws.String("3")},
+	}, opts...); diff != "" {
+		t.Errorf("unexpected delete diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccLexV2ModelsCustomVocabulary_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customvocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+	botLocaleResourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customvocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botLocaleResourceName, "bot_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_items.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_items.*", map[string]string{
+						"display_as": "Terraform",
+						"phrase":     "terraform",
+						"weight":     "3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsCustomVocabulary_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customvocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customvocabulary),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceCustomVocabulary, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsCustomVocabulary_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customvocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customvocabulary),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_items.#", "1"),
+				),
+			},
+			{
+				Config: testAccCustomVocabularyConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customvocabulary),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_items.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_items.*", map[string]string{
+						"display_as": "Terraform",
+						"phrase":     "terraform",
+						"weight":     "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_items.*", map[string]string{
+						"display_as": "HashiCorp",
+						"phrase":     "hashicorp",
+						"weight":     "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomVocabularyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_custom_vocabulary" {
+				continue
+			}
+
+			_, err := tflexv2models.FindCustomVocabularyByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameCustomVocabulary, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomVocabularyExists(ctx context.Context, name string, customvocabulary *lexmodelsv2.DescribeCustomVocabularyMetadataOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindCustomVocabularyByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, rs.Primary.ID, err)
+		}
+
+		*customvocabulary = *resp
+
+		return nil
+	}
+}
+
+func testAccCustomVocabularyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  custom_vocabulary_items {
+    display_as = "Terraform"
+    phrase     = "terraform"
+    weight     = 3
+  }
+}
+`)
+}
+
+func testAccCustomVocabularyConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  custom_vocabulary_items {
+    display_as = "Terraform"
+    phrase     = "terraform"
+    weight     = 2
+  }
+
+  custom_vocabulary_items {
+    display_as = "HashiCorp"
+    phrase     = "hashicorp"
+    weight     = 1
+  }
+}
+`)
+}
@@ -5,7 +5,14 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newResourceBot
-	ResourceBotLocale  = newResourceBotLocale
-	ResourceBotVersion = newResourceBotVersion
+	ResourceBot              = newResourceBot
+	ResourceBotExport        = newResourceBotExport
+	ResourceBotImport        = newResourceBotImport
+	ResourceBotLocale        = newResourceBotLocale
+	ResourceBotVersion       = newResourceBotVersion
+	ResourceCustomVocabulary = newResourceCustomVocabulary
+
+	CustomVocabularyItemChanges = customVocabularyItemChanges
 )
+
+type CustomVocabularyItemData = customVocabularyItemData
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// describe calls the specified Lex V2 describe operation.
+// A ResourceNotFoundException is returned as a *retry.NotFoundError, so that resource Read methods
+// can uniformly remove the resource from state when tfresource.NotFound(err) is true.
+func describe[I, O any](ctx context.Context, in I, f func(context.Context, I, ...func(*lexmodelsv2.Options)) (O, error)) (O, error) {
+	out, err := f(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		var zero O
+		return zero, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	return out, err
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFindNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	conn := lexmodelsv2.New(lexmodelsv2.Options{
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: mockHTTPClientFunc(func(r *http.Request) (*http.Response, error) {
+			header := http.Header{}
+			header.Set("Content-Type", "application/json")
+			header.Set("X-Amzn-Errortype", "ResourceNotFoundException")
+
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"message":"not found"}`))),
+				Request:    r,
+			}, nil
+		}),
+		Region:           names.USWest2RegionID,
+		RetryMaxAttempts: 1,
+	})
+
+	testCases := map[string]func() error{
+		"bot": func() error {
+			_, err := tflexv2models.FindBotByID(ctx, conn, "ABCDEFGHIJ")
+			return err
+		},
+		"bot locale": func() error {
+			_, err := tflexv2models.FindBotLocaleByID(ctx, conn, "en_US,ABCDEFGHIJ,DRAFT")
+			return err
+		},
+		"bot version": func() error {
+			_, err := tflexv2models.FindBotVersionByID(ctx, conn, "ABCDEFGHIJ,1")
+			return err
+		},
+	}
+
+	for name, f := range testCases {
+		name, f := name, f
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := f(); !tfresource.NotFound(err) {
+				t.Errorf("expected NotFound error, got: %v", err)
+			}
+		})
+	}
+}
+
+type mockHTTPClientFunc func(*http.Request) (*http.Response, error)
+
+func (f mockHTTPClientFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
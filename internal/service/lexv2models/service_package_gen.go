@@ -15,7 +15,16 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceBotLocale,
+			Name:    "Bot Locale",
+		},
+		{
+			Factory: newDataSourceBotVersion,
+			Name:    "Bot Version",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -27,6 +36,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory: newResourceBotExport,
+			Name:    "Bot Export",
+		},
+		{
+			Factory: newResourceBotImport,
+			Name:    "Bot Import",
+		},
 		{
 			Factory: newResourceBotLocale,
 			Name:    "Bot Locale",
@@ -35,6 +52,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceBotVersion,
 			Name:    "Bot Version",
 		},
+		{
+			Factory: newResourceCustomVocabulary,
+			Name:    "Custom Vocabulary",
+		},
 	}
 }
 
@@ -6,18 +6,26 @@ package mq
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"net/netip"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -30,12 +38,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mitchellh/copystructure"
+	"golang.org/x/sync/errgroup"
 )
 
 // @SDKResource("aws_mq_broker", name="Broker")
@@ -84,6 +94,14 @@ func resourceBroker() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: ValidateBrokerName,
 			},
+			"broker_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -97,13 +115,24 @@ func resourceBroker() *schema.Resource {
 							Computed: true,
 						},
 						"revision": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppressLatestConfigurationRevision,
 						},
 					},
 				},
 			},
+			"configuration_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"creator_request_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
 			"deployment_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -111,6 +140,16 @@ func resourceBroker() *schema.Resource {
 				Default:          types.DeploymentModeSingleInstance,
 				ValidateDiagFunc: enum.ValidateIgnoreCase[types.DeploymentMode](),
 			},
+			"detect_configuration_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"effective_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// UpdateBroker does not accept encryption options, so any change requires replacement.
 			"encryption_options": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -142,8 +181,9 @@ func resourceBroker() *schema.Resource {
 				ValidateDiagFunc: enum.ValidateIgnoreCase[types.EngineType](),
 			},
 			"engine_version": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEngineVersionUpgraded,
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
@@ -154,6 +194,14 @@ func resourceBroker() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"amqp_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"console_url": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -167,6 +215,22 @@ func resourceBroker() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"mqtt_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"openwire_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stomp_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wss_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -180,7 +244,10 @@ func resourceBroker() *schema.Resource {
 						"hosts": {
 							Type:     schema.TypeList,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBrokerLDAPHost,
+							},
 						},
 						"role_base": {
 							Type:     schema.TypeString,
@@ -199,9 +266,10 @@ func resourceBroker() *schema.Resource {
 							Optional: true,
 						},
 						"service_account_password": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressLDAPServiceAccountPassword,
 						},
 						"service_account_username": {
 							Type:     schema.TypeString,
@@ -240,8 +308,9 @@ func resourceBroker() *schema.Resource {
 							DiffSuppressFunc: nullable.DiffSuppressNullableBoolFalseAsNull,
 						},
 						"general": {
-							Type:     schema.TypeBool,
-							Optional: true,
+							Type:             schema.TypeBool,
+							Optional:         true,
+							DiffSuppressFunc: suppressLogsGeneralNotConfigured,
 						},
 					},
 				},
@@ -269,6 +338,10 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"pending_authentication_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -281,11 +354,18 @@ func resourceBroker() *schema.Resource {
 				MaxItems: 5,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"storage_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
+				ForceNew:         true,
 				ValidateDiagFunc: enum.ValidateIgnoreCase[types.BrokerStorageType](),
+				DiffSuppressFunc: verify.SuppressEquivalentStringCaseInsensitive,
 			},
 			"subnet_ids": {
 				Type:     schema.TypeSet,
@@ -296,20 +376,15 @@ func resourceBroker() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"user": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Set:      resourceUserHash,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// AWS currently does not support updating the RabbitMQ users beyond resource creation.
-					// User list is not returned back after creation.
-					// Updates to users can only be in the RabbitMQ UI.
-					if v := d.Get("engine_type").(string); strings.EqualFold(v, string(types.EngineTypeRabbitmq)) && d.Get("arn").(string) != "" {
-						return true
-					}
-
-					return false
-				},
+				Type:             schema.TypeSet,
+				Required:         true,
+				Set:              resourceUserHash,
+				DiffSuppressFunc: suppressRabbitMQUserFieldsNotReturned,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"console_access": {
@@ -345,10 +420,22 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_steady_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			customizeDiffRabbitMQEngineVersion,
+			customizeDiffHostInstanceType,
+			customizeDiffSubnetIDs,
+			customizeDiffSecurityGroups,
+			customizeDiffConfigurationDocument,
+			customizeDiffReplicationUser,
+			customizeDiffRabbitMQUsers,
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -358,12 +445,328 @@ func resourceBroker() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if strings.EqualFold(diff.Get("authentication_strategy").(string), string(types.AuthenticationStrategyLdap)) {
+					if v, ok := diff.GetOk("ldap_server_metadata"); !ok || len(v.([]interface{})) == 0 {
+						return errors.New("ldap_server_metadata: Must be configured when authentication_strategy is LDAP")
+					}
+				}
+
 				return nil
 			},
 		),
 	}
 }
 
+// brokerEngineVersionsCache caches the engine versions returned by DescribeBrokerEngineTypes, keyed by Region and engine type,
+// so that planning many brokers results in a single API call.
+var brokerEngineVersionsCache sync.Map
+
+func customizeDiffRabbitMQEngineVersion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	engineType := diff.Get("engine_type").(string)
+	if !strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("engine_version") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("engine_version") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+	key := conn.Options().Region + "/" + string(types.EngineTypeRabbitmq)
+
+	var engineVersions []string
+	if v, ok := brokerEngineVersionsCache.Load(key); ok {
+		engineVersions = v.([]string)
+	} else {
+		v, err := findBrokerEngineVersions(ctx, conn, string(types.EngineTypeRabbitmq))
+
+		if err != nil {
+			return fmt.Errorf("reading MQ Broker Engine Types: %w", err)
+		}
+
+		brokerEngineVersionsCache.Store(key, v)
+		engineVersions = v
+	}
+
+	return validateBrokerEngineVersion(diff.Get("engine_version").(string), engineVersions)
+}
+
+// validateBrokerEngineVersion returns an error listing the supported versions if engineVersion is not supported.
+// A <major>.<minor> version is supported if any supported version has that major and minor version.
+func validateBrokerEngineVersion(engineVersion string, engineVersions []string) error {
+	if len(engineVersions) == 0 {
+		return nil
+	}
+
+	for _, v := range engineVersions {
+		if v == engineVersion || strings.HasPrefix(v, engineVersion+".") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("engine_version: %q is not supported, supported versions are: %s", engineVersion, strings.Join(engineVersions, ", "))
+}
+
+// brokerHostInstanceTypeSupport records the engines and deployment modes supported by a host instance type.
+type brokerHostInstanceTypeSupport struct {
+	engineTypes     []types.EngineType
+	deploymentModes []types.DeploymentMode
+}
+
+// brokerHostInstanceTypes lists the host instance types that are only supported by some engines or deployment modes.
+// Host instance types that are not listed are not validated.
+var brokerHostInstanceTypes = map[string]brokerHostInstanceTypeSupport{
+	"mq.t2.micro": {
+		engineTypes:     []types.EngineType{types.EngineTypeActivemq},
+		deploymentModes: []types.DeploymentMode{types.DeploymentModeSingleInstance, types.DeploymentModeActiveStandbyMultiAz},
+	},
+	"mq.t3.micro": {
+		engineTypes:     []types.EngineType{types.EngineTypeActivemq, types.EngineTypeRabbitmq},
+		deploymentModes: []types.DeploymentMode{types.DeploymentModeSingleInstance},
+	},
+}
+
+func customizeDiffHostInstanceType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("deployment_mode", "engine_type", "host_instance_type", "storage_type") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("deployment_mode") || !diff.NewValueKnown("engine_type") || !diff.NewValueKnown("host_instance_type") {
+		return nil
+	}
+
+	hostInstanceType, engineType := diff.Get("host_instance_type").(string), diff.Get("engine_type").(string)
+
+	if err := validateBrokerHostInstanceType(hostInstanceType, engineType, diff.Get("deployment_mode").(string)); err != nil {
+		return err
+	}
+
+	if !diff.NewValueKnown("storage_type") {
+		return nil
+	}
+
+	return validateBrokerStorageType(diff.Get("storage_type").(string), engineType, hostInstanceType)
+}
+
+// validateBrokerHostInstanceType returns an error if the host instance type is not supported by the engine or deployment mode.
+func validateBrokerHostInstanceType(hostInstanceType, engineType, deploymentMode string) error {
+	support, ok := brokerHostInstanceTypes[strings.ToLower(hostInstanceType)]
+	if !ok {
+		return nil
+	}
+
+	if !tfslices.Any(support.engineTypes, func(v types.EngineType) bool { return strings.EqualFold(string(v), engineType) }) {
+		return fmt.Errorf("host_instance_type: %s is not supported by %s", hostInstanceType, engineType)
+	}
+
+	if !tfslices.Any(support.deploymentModes, func(v types.DeploymentMode) bool { return strings.EqualFold(string(v), deploymentMode) }) {
+		return fmt.Errorf("host_instance_type: %s is not supported for %s deployments, supported deployment modes are: %s", hostInstanceType, deploymentMode, strings.Join(enum.Slice(support.deploymentModes...), ", "))
+	}
+
+	return nil
+}
+
+// validateBrokerStorageType returns an error if the storage type is not supported by the engine or host instance type.
+// RabbitMQ only supports EBS, and ActiveMQ only supports EBS on the mq.m5 host instance type family.
+func validateBrokerStorageType(storageType, engineType, hostInstanceType string) error {
+	if storageType == "" {
+		return nil
+	}
+
+	ebs := strings.EqualFold(storageType, string(types.BrokerStorageTypeEbs))
+
+	if strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)) {
+		if !ebs {
+			return fmt.Errorf("storage_type: %s is not supported by %s, only %s is supported", storageType, engineType, types.BrokerStorageTypeEbs)
+		}
+
+		return nil
+	}
+
+	if ebs && !strings.HasPrefix(strings.ToLower(hostInstanceType), "mq.m5.") {
+		return fmt.Errorf("storage_type: %s is not supported by host_instance_type %s, only the mq.m5 family supports %s for %s", storageType, hostInstanceType, types.BrokerStorageTypeEbs, engineType)
+	}
+
+	return nil
+}
+
+func customizeDiffSubnetIDs(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// UpdateBroker has no deployment mode parameter, so any change to deployment_mode replaces the broker.
+	replacing := diff.Id() != "" && diff.HasChange("deployment_mode")
+	o, n := diff.GetChange("deployment_mode")
+
+	if !diff.NewValueKnown("subnet_ids") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("subnet_ids")
+	if !ok {
+		return nil
+	}
+
+	if replacing {
+		return validateBrokerDeploymentModeTransition(o.(string), n.(string), v.(*schema.Set).Len())
+	}
+
+	return validateBrokerSubnetIDs(n.(string), v.(*schema.Set).Len())
+}
+
+func customizeDiffSecurityGroups(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("security_groups") {
+		return nil
+	}
+
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	if securityGroupsWithEmptySubnetIDs(config.GetAttr("security_groups"), config.GetAttr("subnet_ids")) {
+		return errors.New("subnet_ids: must not be empty when security_groups is set, as the broker would be placed in the default VPC; omit subnet_ids to use the default VPC")
+	}
+
+	return nil
+}
+
+func customizeDiffConfigurationDocument(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	if config.GetAttr("configuration_document").IsNull() {
+		return nil
+	}
+
+	if v := config.GetAttr("configuration"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return errors.New(`"configuration_document" cannot be specified with "configuration"`)
+	}
+
+	return nil
+}
+
+func customizeDiffReplicationUser(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("engine_type") || !diff.NewValueKnown("user") {
+		return nil
+	}
+
+	return validateBrokerReplicationUsers(diff.Get("engine_type").(string), diff.Get("user").(*schema.Set).List())
+}
+
+// customizeDiffRabbitMQUsers rejects changes to the usernames of an existing RabbitMQ broker.
+// AWS does not support updating RabbitMQ users beyond resource creation; updates can only be made in the RabbitMQ UI.
+func customizeDiffRabbitMQUsers(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown("user") {
+		return nil
+	}
+
+	if !strings.EqualFold(diff.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
+		return nil
+	}
+
+	if o, n := diff.GetChange("user"); !brokerUsernames(o.(*schema.Set)).Equal(brokerUsernames(n.(*schema.Set))) {
+		return errors.New("user: Can not be changed for an existing RabbitMQ broker, as AWS does not support updating RabbitMQ users; make changes in the RabbitMQ management UI")
+	}
+
+	return nil
+}
+
+// brokerRebootAttributes lists the attributes whose changes only take effect once the broker is rebooted.
+// Changes to "user" are those actually made by updateBrokerUsers, see resourceBrokerUpdate.
+var brokerRebootAttributes = []string{
+	"authentication_strategy",
+	"auto_minor_version_upgrade",
+	"configuration",
+	"configuration_document",
+	"engine_version",
+	"host_instance_type",
+	"logs",
+	"maintenance_window_start_time",
+	"user",
+}
+
+// brokerRebootWarning returns a warning naming the changed attributes that require a reboot if apply_immediately is not set,
+// as the changes then only take effect in the next maintenance window.
+func brokerRebootWarning(id string, applyImmediately bool, hasChange func(string) bool) string {
+	if applyImmediately {
+		return ""
+	}
+
+	changed := tfslices.Filter(brokerRebootAttributes, hasChange)
+	if len(changed) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("MQ Broker (%s) changes to %s require a reboot and apply_immediately is false, so they take effect in the next maintenance window", id, strings.Join(changed, ", "))
+}
+
+// validateBrokerReplicationUsers returns an error if any user is a replication user and the engine is not ActiveMQ.
+// Replication users are only used for ActiveMQ cross-region data replication.
+func validateBrokerReplicationUsers(engineType string, users []interface{}) error {
+	if strings.EqualFold(engineType, string(types.EngineTypeActivemq)) {
+		return nil
+	}
+
+	for _, v := range users {
+		if u, ok := v.(map[string]interface{}); ok && u["replication_user"] == true {
+			return fmt.Errorf("user.replication_user: Can not be configured when engine is %s, replication users are only supported by ActiveMQ", engineType)
+		}
+	}
+
+	return nil
+}
+
+// securityGroupsWithEmptySubnetIDs returns whether a broker is configured with security groups and an empty list of subnet IDs.
+// Values that are not yet known are not checked. Omitting subnet IDs, which places the broker in the default VPC, is allowed.
+func securityGroupsWithEmptySubnetIDs(securityGroups, subnetIDs cty.Value) bool {
+	if !securityGroups.IsKnown() || securityGroups.IsNull() || securityGroups.LengthInt() == 0 {
+		return false
+	}
+
+	if !subnetIDs.IsKnown() || subnetIDs.IsNull() {
+		return false
+	}
+
+	return subnetIDs.LengthInt() == 0
+}
+
+// validateBrokerDeploymentModeTransition checks that the subnets configured for a broker whose deployment mode is changing
+// suit the new deployment mode, explaining that the change is made by replacing the broker.
+func validateBrokerDeploymentModeTransition(oldMode, newMode string, n int) error {
+	if err := validateBrokerSubnetIDs(newMode, n); err != nil {
+		return fmt.Errorf("changing deployment_mode from %s to %s replaces the broker, and the replacement broker's subnets must suit the new deployment mode: %w", oldMode, newMode, err)
+	}
+
+	return nil
+}
+
+// validateBrokerSubnetIDs checks that the number of configured subnets is consistent with the deployment mode.
+// CLUSTER_MULTI_AZ deployments place nodes in the subnets' Availability Zones and have no fixed subnet count.
+func validateBrokerSubnetIDs(deploymentMode string, n int) error {
+	var want int
+	switch {
+	case strings.EqualFold(deploymentMode, string(types.DeploymentModeSingleInstance)):
+		want = 1
+	case strings.EqualFold(deploymentMode, string(types.DeploymentModeActiveStandbyMultiAz)):
+		want = 2
+	default:
+		return nil
+	}
+
+	if n != want {
+		return fmt.Errorf("subnet_ids: %s deployment mode requires exactly %d subnet(s), got %d", deploymentMode, want, n)
+	}
+
+	return nil
+}
+
 func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -380,7 +783,7 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		HostInstanceType:        aws.String(d.Get("host_instance_type").(string)),
 		PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 		Tags:                    getTagsIn(ctx),
-		Users:                   expandUsers(d.Get("user").(*schema.Set).List()),
+		Users:                   expandUsers(d.Get("engine_type").(string), d.Get("user").(*schema.Set).List()),
 	}
 
 	if v, ok := d.GetOk("authentication_strategy"); ok {
@@ -389,6 +792,18 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Configuration = expandConfigurationId(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("configuration_document"); ok {
+		configurationID, err := createBrokerConfiguration(ctx, conn, d, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s) configuration: %s", name, err)
+		}
+
+		input.Configuration = configurationID
+	}
+	if v, ok := d.GetOk("creator_request_id"); ok {
+		input.CreatorRequestId = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("deployment_mode"); ok {
 		input.DeploymentMode = types.DeploymentMode(v.(string))
 	}
@@ -417,6 +832,10 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	output, err := conn.CreateBroker(ctx, input)
 
 	if err != nil {
+		if _, ok := d.GetOk("configuration_document"); ok {
+			diags = sdkdiag.AppendWarningf(diags, "MQ Configuration (%s) created for configuration_document cannot be deleted, it is revised by the next attempt to create MQ Broker (%s)", aws.ToString(input.Configuration.Id), name)
+		}
+
 		return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s): %s", name, err)
 	}
 
@@ -431,9 +850,14 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
 
-	conn := meta.(*conns.AWSClient).MQClient(ctx)
+	return readBroker(ctx, d, c.MQClient(ctx), c.EC2Client(ctx))
+}
+
+// readBroker refreshes the broker's state using the specified API clients.
+func readBroker(ctx context.Context, d *schema.ResourceData, conn *mq.Client, ec2conn *ec2.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	output, err := findBrokerByID(ctx, conn, d.Id())
 
@@ -451,15 +875,41 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("authentication_strategy", output.AuthenticationStrategy)
 	d.Set("auto_minor_version_upgrade", output.AutoMinorVersionUpgrade)
 	d.Set("broker_name", output.BrokerName)
+	d.Set("broker_state", output.BrokerState)
+	if v, ok := flattenClusterMemberCount(output.DeploymentMode, output.BrokerInstances); ok {
+		d.Set("cluster_member_count", v)
+	} else {
+		d.Set("cluster_member_count", nil)
+	}
 	d.Set("deployment_mode", output.DeploymentMode)
 	d.Set("engine_type", output.EngineType)
-	d.Set("engine_version", output.EngineVersion)
 	d.Set("host_instance_type", output.HostInstanceType)
-	d.Set("instances", flattenBrokerInstances(output.BrokerInstances))
+
+	// Subnets are only described when the broker's subnets or instance IP addresses have changed since the last refresh.
+	azByCIDRBlock, ok := instanceAvailabilityZonesFromState(d, output.SubnetIds, output.BrokerInstances)
+	if !ok {
+		var err error
+		azByCIDRBlock, err = findSubnetAvailabilityZonesByCIDRBlock(ctx, ec2conn, output.SubnetIds)
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "reading MQ Broker (%s) subnets, instances availability_zone not set: %s", d.Id(), err)
+		}
+	}
+	d.Set("instances", flattenBrokerInstances(output.BrokerInstances, azByCIDRBlock))
+	d.Set("pending_authentication_strategy", output.PendingAuthenticationStrategy)
+
+	d.Set("effective_engine_version", output.EngineVersion)
+	d.Set("engine_version", output.EngineVersion)
 	d.Set("publicly_accessible", output.PubliclyAccessible)
 	d.Set("security_groups", output.SecurityGroups)
 	d.Set("storage_type", output.StorageType)
 	d.Set("subnet_ids", output.SubnetIds)
+	d.Set("update_pending", brokerUpdatePending(output))
+
+	if d.Get("detect_configuration_drift").(bool) {
+		if revision, ok := configurationDrift(d.Get("configuration").([]interface{}), output.Configurations); ok {
+			diags = sdkdiag.AppendWarningf(diags, "MQ Broker (%s) configuration drift: active revision (%d) differs from configured revision (%d)", d.Id(), revision, d.Get("configuration.0.revision").(int))
+		}
+	}
 
 	if err := d.Set("configuration", flattenConfiguration(output.Configurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
@@ -478,7 +928,7 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
 	}
 
-	if err := d.Set("logs", flattenLogs(output.Logs)); err != nil {
+	if err := d.Set("logs", flattenLogs(string(output.EngineType), output.Logs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting logs: %s", err)
 	}
 
@@ -486,14 +936,16 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting maintenance_window_start_time: %s", err)
 	}
 
-	rawUsers, err := expandUsersForBroker(ctx, conn, d.Id(), output.Users)
+	rawUsers, ok, err := findBrokerUsers(ctx, conn, d.Id(), output)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s) users: %s", d.Id(), err)
 	}
 
-	if err := d.Set("user", flattenUsers(rawUsers, d.Get("user").(*schema.Set).List())); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting user: %s", err)
+	if ok {
+		if err := d.Set("user", flattenUsers(rawUsers, d.Get("user").(*schema.Set).List())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting user: %s", err)
+		}
 	}
 
 	setTagsOut(ctx, output.Tags)
@@ -506,44 +958,18 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	conn := meta.(*conns.AWSClient).MQClient(ctx)
 
-	requiresReboot := false
-
-	if d.HasChange("security_groups") {
-		input := &mq.UpdateBrokerInput{
-			BrokerId:       aws.String(d.Id()),
-			SecurityGroups: flex.ExpandStringValueSet(d.Get("security_groups").(*schema.Set)),
-		}
-
-		_, err := conn.UpdateBroker(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) security groups: %s", d.Id(), err)
-		}
-	}
-
-	if d.HasChanges("configuration", "logs", "engine_version") {
-		input := &mq.UpdateBrokerInput{
-			BrokerId:      aws.String(d.Id()),
-			Configuration: expandConfigurationId(d.Get("configuration").([]interface{})),
-			EngineVersion: aws.String(d.Get("engine_version").(string)),
-			Logs:          expandLogs(d.Get("engine_type").(string), d.Get("logs").([]interface{})),
-		}
-
-		_, err := conn.UpdateBroker(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) configuration: %s", d.Id(), err)
-		}
+	requiresReboot, err := updateBroker(ctx, conn, d)
 
-		requiresReboot = true
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s): %s", d.Id(), err)
 	}
 
-	if d.HasChange("user") {
+	// RabbitMQ users cannot be updated, see customizeDiffRabbitMQUsers.
+	var usersUpdated bool
+	if d.HasChange("user") && !strings.EqualFold(d.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
 		o, n := d.GetChange("user")
-		var err error
 		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
 		// updateBrokerUsers needs to be called to know if changes to user are actually made
-		var usersUpdated bool
 		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
 
 		if err != nil {
@@ -555,49 +981,19 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("host_instance_type") {
-		input := &mq.UpdateBrokerInput{
-			BrokerId:         aws.String(d.Id()),
-			HostInstanceType: aws.String(d.Get("host_instance_type").(string)),
-		}
-
-		_, err := conn.UpdateBroker(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) host instance type: %s", d.Id(), err)
-		}
-
-		requiresReboot = true
-	}
-
-	if d.HasChange("auto_minor_version_upgrade") {
-		input := &mq.UpdateBrokerInput{
-			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-			BrokerId:                aws.String(d.Id()),
-		}
-
-		_, err := conn.UpdateBroker(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) auto minor version upgrade: %s", d.Id(), err)
-		}
-
-		requiresReboot = true
-	}
+	// SDKv2 CustomizeDiff cannot return warnings, so deferred changes are reported when they are applied.
+	if requiresReboot {
+		hasChange := func(k string) bool {
+			if k == "user" {
+				return usersUpdated
+			}
 
-	if d.HasChange("maintenance_window_start_time") {
-		input := &mq.UpdateBrokerInput{
-			BrokerId:                   aws.String(d.Id()),
-			MaintenanceWindowStartTime: expandWeeklyStartTime(d.Get("maintenance_window_start_time").([]interface{})),
+			return d.HasChange(k)
 		}
 
-		_, err := conn.UpdateBroker(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) maintenance window start time: %s", d.Id(), err)
+		if msg := brokerRebootWarning(d.Id(), d.Get("apply_immediately").(bool), hasChange); msg != "" {
+			diags = sdkdiag.AppendWarningf(diags, "%s", msg)
 		}
-
-		requiresReboot = true
 	}
 
 	if d.Get("apply_immediately").(bool) && requiresReboot {
@@ -614,18 +1010,27 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.Get("wait_for_steady_state").(bool) {
+		if _, err := waitBrokerUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
 func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining MQ Broker: %s", d.Id())
+		return diags
+	}
+
 	conn := meta.(*conns.AWSClient).MQClient(ctx)
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
-	_, err := conn.DeleteBroker(ctx, &mq.DeleteBrokerInput{
-		BrokerId: aws.String(d.Id()),
-	})
+	err := deleteBroker(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if errs.IsA[*types.NotFoundException](err) {
 		return diags
@@ -642,6 +1047,34 @@ func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// deleteBroker deletes the specified broker, retrying while the broker is rebooting or applying an update.
+// Deletion fails immediately with the required actions if the broker is in the CRITICAL_ACTION_REQUIRED state.
+func deleteBroker(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) error {
+	input := &mq.DeleteBrokerInput{
+		BrokerId: aws.String(id),
+	}
+
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.DeleteBroker(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// DeleteBroker does not model ConflictException, so the error is not deserialized as *types.ConflictException.
+			if !tfawserr.ErrCodeEquals(err, errCodeConflictException) {
+				return false, err
+			}
+
+			if output, err := findBrokerByID(ctx, conn, id); err == nil && output.BrokerState == types.BrokerStateCriticalActionRequired {
+				return false, brokerActionsRequiredError(output.ActionsRequired)
+			}
+
+			return true, err
+		},
+	)
+
+	return err
+}
+
 func findBrokerByID(ctx context.Context, conn *mq.Client, id string) (*mq.DescribeBrokerOutput, error) {
 	input := &mq.DescribeBrokerInput{
 		BrokerId: aws.String(id),
@@ -667,6 +1100,35 @@ func findBrokerByID(ctx context.Context, conn *mq.Client, id string) (*mq.Descri
 	return output, nil
 }
 
+func findBrokerEngineVersions(ctx context.Context, conn *mq.Client, engineType string) ([]string, error) {
+	input := &mq.DescribeBrokerEngineTypesInput{
+		EngineType: aws.String(engineType),
+	}
+	var output []string
+
+	for {
+		page, err := conn.DescribeBrokerEngineTypes(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.BrokerEngineTypes {
+			for _, v := range v.EngineVersions {
+				output = append(output, aws.ToString(v.Name))
+			}
+		}
+
+		if page.NextToken == nil {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 func statusBrokerState(ctx context.Context, conn *mq.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findBrokerByID(ctx, conn, id)
@@ -679,10 +1141,28 @@ func statusBrokerState(ctx context.Context, conn *mq.Client, id string) retry.St
 			return nil, "", err
 		}
 
+		if output.BrokerState == types.BrokerStateCriticalActionRequired {
+			return output, string(output.BrokerState), brokerActionsRequiredError(output.ActionsRequired)
+		}
+
 		return output, string(output.BrokerState), nil
 	}
 }
 
+// brokerActionsRequiredError returns an error describing the manual actions required to resolve a broker in the CRITICAL_ACTION_REQUIRED state.
+func brokerActionsRequiredError(apiObjects []types.ActionRequired) error {
+	actions := make([]string, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		actions = append(actions, fmt.Sprintf("%s: %s", aws.ToString(apiObject.ActionRequiredCode), aws.ToString(apiObject.ActionRequiredInfo)))
+	}
+
+	if len(actions) == 0 {
+		return fmt.Errorf("broker is in state %s and requires manual action", types.BrokerStateCriticalActionRequired)
+	}
+
+	return fmt.Errorf("broker is in state %s and requires manual action (%s)", types.BrokerStateCriticalActionRequired, strings.Join(actions, "; "))
+}
+
 func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
 		Pending: enum.Slice(types.BrokerStateCreationInProgress, types.BrokerStateRebootInProgress),
@@ -701,39 +1181,124 @@ func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout
 
 func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
 	stateConf := retry.StateChangeConf{
-		Pending: enum.Slice(
-			types.BrokerStateCreationFailed,
-			types.BrokerStateDeletionInProgress,
-			types.BrokerStateRebootInProgress,
-			types.BrokerStateRunning,
-		),
+		Pending: enum.ValuesExcept(types.BrokerStateCreationInProgress, types.BrokerStateCriticalActionRequired, types.BrokerStateReplica),
 		Target:  []string{},
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitBrokerUpdated waits for the broker to be consistently RUNNING after an update.
+func waitBrokerUpdated(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	stateConf := retry.StateChangeConf{
+		Pending:                   enum.Slice(types.BrokerStateRebootInProgress),
+		Target:                    enum.Slice(types.BrokerStateRunning),
+		Timeout:                   timeout,
+		Refresh:                   statusBrokerState(ctx, conn, id),
+		ContinuousTargetOccurence: 2,
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	stateConf := retry.StateChangeConf{
+		Pending: enum.Slice(types.BrokerStateRebootInProgress),
+		Target:  enum.Slice(types.BrokerStateRunning),
+		Timeout: timeout,
+		Refresh: statusBrokerState(ctx, conn, id),
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// suppressRabbitMQUserFieldsNotReturned suppresses the user diff of an existing RabbitMQ broker when the configured and
+// current users have the same usernames, as the other user fields are not returned for RabbitMQ (see findBrokerUsers).
+// AWS currently does not support updating RabbitMQ users beyond resource creation; updates can only be made in the RabbitMQ UI.
+func suppressRabbitMQUserFieldsNotReturned(k, old, new string, d *schema.ResourceData) bool {
+	if v := d.Get("engine_type").(string); !strings.EqualFold(v, string(types.EngineTypeRabbitmq)) || d.Get("arn").(string) == "" {
+		return false
+	}
+
+	o, n := d.GetChange("user")
+
+	return brokerUsernames(o.(*schema.Set)).Equal(brokerUsernames(n.(*schema.Set)))
+}
+
+// brokerUsernames returns the usernames of the specified users.
+func brokerUsernames(users *schema.Set) *schema.Set {
+	usernames := schema.NewSet(schema.HashString, nil)
+	for _, v := range users.List() {
+		if u, ok := v.(map[string]interface{}); ok {
+			usernames.Add(u["username"])
+		}
+	}
+
+	return usernames
+}
+
+// suppressEngineVersionUpgraded suppresses the engine version diff when auto_minor_version_upgrade is enabled and the broker
+// is running a newer patch level of the configured version, treating the configured version as a floor.
+// It also suppresses the diff for a RabbitMQ broker configured with a <major>.<minor> version.
+func suppressEngineVersionUpgraded(k, old, new string, d *schema.ResourceData) bool {
+	// AWS selects the patch version for a RabbitMQ broker configured with a <major>.<minor> version.
+	if strings.EqualFold(d.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) && engineVersionMajorMinorMatches(new, old) {
+		return true
+	}
 
-	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
-		return output, err
+	if !d.Get("auto_minor_version_upgrade").(bool) {
+		return false
 	}
 
-	return nil, err
+	return engineVersionPatchUpgraded(new, old)
 }
 
-func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
-	stateConf := retry.StateChangeConf{
-		Pending: enum.Slice(types.BrokerStateRebootInProgress),
-		Target:  enum.Slice(types.BrokerStateRunning),
-		Timeout: timeout,
-		Refresh: statusBrokerState(ctx, conn, id),
+// engineVersionMajorMinorMatches returns whether configured is a <major>.<minor> version and running is a
+// <major>.<minor>.<patch> version with the same major and minor version.
+func engineVersionMajorMinorMatches(configured, running string) bool {
+	if len(strings.Split(configured, ".")) != 2 {
+		return false
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
-		return output, err
+	return strings.HasPrefix(running, configured+".")
+}
+
+// engineVersionPatchUpgraded returns whether the running engine version is a newer patch level of the configured version,
+// e.g. as applied by auto_minor_version_upgrade.
+func engineVersionPatchUpgraded(configured, running string) bool {
+	c, err := gversion.NewVersion(configured)
+	if err != nil {
+		return false
 	}
 
-	return nil, err
+	r, err := gversion.NewVersion(running)
+	if err != nil {
+		return false
+	}
+
+	cs, rs := c.Segments(), r.Segments()
+	if cs[0] != rs[0] || cs[1] != rs[1] {
+		return false
+	}
+
+	return r.GreaterThan(c)
 }
 
 func resourceUserHash(v interface{}) int {
@@ -746,7 +1311,16 @@ func resourceUserHash(v interface{}) int {
 		buf.WriteString("false-")
 	}
 	if g, ok := m["groups"]; ok {
-		buf.WriteString(fmt.Sprintf("%v-", g.([]string)))
+		// Groups are a []string when flattened from the API and a set when read from configuration or state.
+		var groups []string
+		switch g := g.(type) {
+		case []string:
+			groups = append(groups, g...)
+		case *schema.Set:
+			groups = flex.ExpandStringValueSet(g)
+		}
+		sort.Strings(groups)
+		buf.WriteString(fmt.Sprintf("%v-", groups))
 	}
 	if p, ok := m["password"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", p.(string)))
@@ -923,7 +1497,8 @@ func ValidBrokerPassword(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
-func expandUsers(cfg []interface{}) []types.User {
+func expandUsers(engineType string, cfg []interface{}) []types.User {
+	activeMQ := strings.EqualFold(engineType, string(types.EngineTypeActivemq))
 	users := make([]types.User, len(cfg))
 	for i, m := range cfg {
 		u := m.(map[string]interface{})
@@ -934,7 +1509,7 @@ func expandUsers(cfg []interface{}) []types.User {
 		if v, ok := u["console_access"]; ok {
 			user.ConsoleAccess = aws.Bool(v.(bool))
 		}
-		if v, ok := u["replication_user"]; ok {
+		if v, ok := u["replication_user"]; ok && activeMQ {
 			user.ReplicationUser = aws.Bool(v.(bool))
 		}
 		if v, ok := u["groups"]; ok {
@@ -945,39 +1520,76 @@ func expandUsers(cfg []interface{}) []types.User {
 	return users
 }
 
+// describeUserConcurrency is the maximum number of concurrent DescribeUser calls made when reading a broker's users.
+const describeUserConcurrency = 10
+
+// findBrokerUsers returns the broker's users and whether they were returned by the API.
+// Amazon MQ does not currently return the users of a RabbitMQ broker, in which case the users
+// already in state are retained rather than being reconciled.
+func findBrokerUsers(ctx context.Context, conn *mq.Client, brokerId string, output *mq.DescribeBrokerOutput) ([]*types.User, bool, error) {
+	if output.EngineType == types.EngineTypeRabbitmq && len(output.Users) == 0 {
+		return nil, false, nil
+	}
+
+	rawUsers, err := expandUsersForBroker(ctx, conn, brokerId, output.Users)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return rawUsers, true, nil
+}
+
 func expandUsersForBroker(ctx context.Context, conn *mq.Client, brokerId string, input []types.UserSummary) ([]*types.User, error) {
-	var rawUsers []*types.User
+	rawUsers := make([]*types.User, len(input))
 
-	for _, u := range input {
-		uOut, err := conn.DescribeUser(ctx, &mq.DescribeUserInput{
-			BrokerId: aws.String(brokerId),
-			Username: u.Username,
-		})
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeUserConcurrency)
 
-		if err != nil {
-			return nil, err
-		}
+	for i, u := range input {
+		i, u := i, u
 
-		user := &types.User{
-			ConsoleAccess:   uOut.ConsoleAccess,
-			Groups:          uOut.Groups,
-			ReplicationUser: uOut.ReplicationUser,
-			Username:        uOut.Username,
-		}
+		g.Go(func() error {
+			uOut, err := conn.DescribeUser(ctx, &mq.DescribeUserInput{
+				BrokerId: aws.String(brokerId),
+				Username: u.Username,
+			})
+
+			if err != nil {
+				return err
+			}
 
-		rawUsers = append(rawUsers, user)
+			// Each goroutine writes only its own index, preserving the order of the input.
+			rawUsers[i] = &types.User{
+				ConsoleAccess:   uOut.ConsoleAccess,
+				Groups:          uOut.Groups,
+				ReplicationUser: uOut.ReplicationUser,
+				Username:        uOut.Username,
+			}
+
+			// Fall back to the summary's username should the user details omit it.
+			if rawUsers[i].Username == nil {
+				rawUsers[i].Username = u.Username
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
 	return rawUsers, nil
 }
 
-// We use cfgdUsers to get & set the password
+// flattenUsers uses cfgUsers to set the password, which is never returned by the API,
+// and any other attribute the API omits, so that partial user details don't cause diffs.
 func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
-	existingPairs := make(map[string]string)
+	cfgUsersByName := make(map[string]map[string]interface{})
 	for _, u := range cfgUsers {
 		user := u.(map[string]interface{})
-		username := user["username"].(string)
-		existingPairs[username] = user["password"].(string)
+		cfgUsersByName[user["username"].(string)] = user
 	}
 
 	out := make([]interface{}, 0)
@@ -985,21 +1597,24 @@ func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
 		m := map[string]interface{}{
 			"username": aws.ToString(u.Username),
 		}
-		password := ""
-		if p, ok := existingPairs[aws.ToString(u.Username)]; ok {
-			password = p
-		}
-		if password != "" {
-			m["password"] = password
+		cfgUser := cfgUsersByName[aws.ToString(u.Username)]
+		if v, ok := cfgUser["password"].(string); ok && v != "" {
+			m["password"] = v
 		}
 		if u.ConsoleAccess != nil {
 			m["console_access"] = aws.ToBool(u.ConsoleAccess)
+		} else if v, ok := cfgUser["console_access"]; ok {
+			m["console_access"] = v
 		}
 		if u.ReplicationUser != nil {
 			m["replication_user"] = aws.ToBool(u.ReplicationUser)
+		} else if v, ok := cfgUser["replication_user"]; ok {
+			m["replication_user"] = v
 		}
 		if len(u.Groups) > 0 {
 			m["groups"] = u.Groups
+		} else if v, ok := cfgUser["groups"].(*schema.Set); ok && u.Groups == nil && v.Len() > 0 {
+			m["groups"] = flex.ExpandStringValueSet(v)
 		}
 		out = append(out, m)
 	}
@@ -1036,6 +1651,235 @@ func flattenWeeklyStartTime(wst *types.WeeklyStartTime) []interface{} {
 	return []interface{}{m}
 }
 
+// updateBroker sends all changed broker attributes other than users in a single UpdateBroker call,
+// so that the broker is only rebooted once. It reports whether the changes require a reboot.
+func updateBroker(ctx context.Context, conn *mq.Client, d *schema.ResourceData) (bool, error) {
+	input := &mq.UpdateBrokerInput{
+		BrokerId: aws.String(d.Id()),
+	}
+	update, requiresReboot := false, false
+
+	if d.HasChange("authentication_strategy") {
+		input.AuthenticationStrategy = types.AuthenticationStrategy(d.Get("authentication_strategy").(string))
+		update, requiresReboot = true, true
+	}
+
+	if d.HasChange("auto_minor_version_upgrade") {
+		input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		update, requiresReboot = true, true
+	}
+
+	if d.HasChanges("configuration", "engine_version") {
+		v := expandUpdateBrokerConfigurationInput(d)
+		input.Configuration = v.Configuration
+		input.EngineVersion = v.EngineVersion
+		update, requiresReboot = true, true
+	}
+
+	// The configuration document has to be saved before the broker can reference it.
+	if d.HasChange("configuration_document") {
+		if o, n := d.GetChange("configuration_document"); n.(string) != "" {
+			var configurationID *types.ConfigurationId
+			var err error
+
+			// Revise the configuration created for the previous document, otherwise create a new configuration
+			// rather than revising one that the broker merely references.
+			if o.(string) != "" {
+				id, _ := d.GetChange("configuration.0.id")
+				configurationID, err = updateConfigurationDocument(ctx, conn, id.(string), n.(string))
+			} else {
+				configurationID, err = createBrokerConfiguration(ctx, conn, d, n.(string))
+			}
+
+			if err != nil {
+				return false, fmt.Errorf("configuration document: %w", err)
+			}
+
+			input.Configuration = configurationID
+			update, requiresReboot = true, true
+		}
+	}
+
+	if d.HasChange("host_instance_type") {
+		input.HostInstanceType = aws.String(d.Get("host_instance_type").(string))
+		update, requiresReboot = true, true
+	}
+
+	if d.HasChange("logs") {
+		engineType := d.Get("engine_type").(string)
+		input.Logs = expandLogs(engineType, d.Get("logs").([]interface{}))
+
+		// Removing a previously enabled audit setting disables audit logging rather than leaving it unchanged.
+		if o, n := d.GetChange("logs.0.audit"); input.Logs != nil && input.Logs.Audit == nil && !strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)) {
+			if ov, _, _ := nullable.Bool(o.(string)).Value(); ov && nullable.Bool(n.(string)).IsNull() {
+				input.Logs.Audit = aws.Bool(false)
+			}
+		}
+
+		update, requiresReboot = true, true
+	}
+
+	if d.HasChange("maintenance_window_start_time") {
+		input.MaintenanceWindowStartTime = expandWeeklyStartTime(d.Get("maintenance_window_start_time").([]interface{}))
+		update, requiresReboot = true, true
+	}
+
+	if d.HasChange("security_groups") {
+		input.SecurityGroups = flex.ExpandStringValueSet(d.Get("security_groups").(*schema.Set))
+		update = true
+	}
+
+	if !update {
+		return false, nil
+	}
+
+	if _, err := conn.UpdateBroker(ctx, input); err != nil {
+		return false, err
+	}
+
+	return requiresReboot, nil
+}
+
+// expandUpdateBrokerConfigurationInput returns the UpdateBroker input for configuration and engine version changes.
+// The engine version is only sent when it has changed so that a configuration-only update isn't treated as an upgrade.
+// When switching to a different configuration without specifying a revision, the revision carried over from the previous
+// configuration is not sent so that the latest revision of the new configuration is used.
+func expandUpdateBrokerConfigurationInput(d *schema.ResourceData) *mq.UpdateBrokerInput {
+	input := &mq.UpdateBrokerInput{
+		BrokerId:      aws.String(d.Id()),
+		Configuration: expandConfigurationId(d.Get("configuration").([]interface{})),
+	}
+
+	if input.Configuration != nil && d.HasChange("configuration.0.id") && !configurationRevisionConfigured(d.GetRawConfig()) {
+		input.Configuration.Revision = nil
+	}
+
+	if d.HasChange("engine_version") {
+		input.EngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	return input
+}
+
+// configurationRevisionConfigured returns whether configuration.0.revision is set in the specified raw configuration.
+func configurationRevisionConfigured(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	v := config.GetAttr("configuration")
+	if v.IsNull() || !v.IsKnown() || v.LengthInt() == 0 {
+		return false
+	}
+
+	v = v.Index(cty.NumberIntVal(0))
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+
+	return !v.GetAttr("revision").IsNull()
+}
+
+// suppressLogsGeneralNotConfigured suppresses the general logging diff of a RabbitMQ broker whose logs block is not
+// configured while AWS reports general logging as off, its default, as there is then nothing to turn off.
+// Removing the logs block from a broker with general logging on still plans to turn it off.
+func suppressLogsGeneralNotConfigured(k, old, new string, d *schema.ResourceData) bool {
+	if !strings.EqualFold(d.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
+		return false
+	}
+
+	if old == "true" {
+		return false
+	}
+
+	return !logsConfigured(d.GetRawConfig())
+}
+
+// logsConfigured returns whether the logs block is configured.
+// The logs block is assumed to be configured if the configuration is not known.
+func logsConfigured(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return true
+	}
+
+	v := config.GetAttr("logs")
+
+	return !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0)
+}
+
+// brokerConfigurationDescription is the description of each configuration revision created for configuration_document.
+// It identifies a configuration created for a broker of the same name that can be revised rather than created again.
+const brokerConfigurationDescription = "Managed by Terraform for MQ Broker configuration_document"
+
+// createBrokerConfiguration creates a configuration for the broker whose latest revision holds the specified document.
+// Delete is not available in the API, so the configuration outlives the broker and the removal of the document.
+// A configuration retained from a previous attempt to create the broker (e.g. one where CreateBroker failed), or from a
+// previous broker of the same name, is revised instead of creating another.
+func createBrokerConfiguration(ctx context.Context, conn *mq.Client, d *schema.ResourceData, document string) (*types.ConfigurationId, error) {
+	name := d.Get("broker_name").(string)
+	engineType := d.Get("engine_type").(string)
+	engineVersion := d.Get("engine_version").(string)
+
+	configurations, err := findConfigurations(ctx, conn, &mq.ListConfigurationsInput{}, func(v *types.Configuration) bool {
+		if aws.ToString(v.Name) != name || !strings.EqualFold(string(v.EngineType), engineType) || aws.ToString(v.EngineVersion) != engineVersion {
+			return false
+		}
+
+		return v.LatestRevision != nil && aws.ToString(v.LatestRevision.Description) == brokerConfigurationDescription
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configurations) > 0 {
+		return updateConfigurationDocument(ctx, conn, aws.ToString(configurations[0].Id), document)
+	}
+
+	input := &mq.CreateConfigurationInput{
+		EngineType:    types.EngineType(engineType),
+		EngineVersion: aws.String(engineVersion),
+		Name:          aws.String(name),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("authentication_strategy"); ok {
+		input.AuthenticationStrategy = types.AuthenticationStrategy(v.(string))
+	}
+
+	output, err := conn.CreateConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return updateConfigurationDocument(ctx, conn, aws.ToString(output.Id), document)
+}
+
+// updateConfigurationDocument creates a new revision of the specified configuration holding the specified document.
+func updateConfigurationDocument(ctx context.Context, conn *mq.Client, configurationID, document string) (*types.ConfigurationId, error) {
+	input := &mq.UpdateConfigurationInput{
+		ConfigurationId: aws.String(configurationID),
+		Data:            aws.String(base64.StdEncoding.EncodeToString([]byte(document))),
+		Description:     aws.String(brokerConfigurationDescription),
+	}
+
+	output, err := conn.UpdateConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiObject := &types.ConfigurationId{
+		Id: output.Id,
+	}
+	if output.LatestRevision != nil {
+		apiObject.Revision = output.LatestRevision.Revision
+	}
+
+	return apiObject, nil
+}
+
 func expandConfigurationId(cfg []interface{}) *types.ConfigurationId {
 	if len(cfg) < 1 {
 		return nil
@@ -1052,6 +1896,30 @@ func expandConfigurationId(cfg []interface{}) *types.ConfigurationId {
 	return &out
 }
 
+// suppressLatestConfigurationRevision suppresses the configuration revision diff when the revision is omitted
+// and the configuration ID is unchanged, as the broker is then using the revision resolved at create (or import) time.
+func suppressLatestConfigurationRevision(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" && new != "0" {
+		return false
+	}
+
+	if old == "" || old == "0" {
+		return false
+	}
+
+	return !d.HasChange("configuration.0.id")
+}
+
+// suppressLDAPServiceAccountPassword suppresses the LDAP service account password diff when the password is omitted
+// from configuration, or when the broker was imported, as the password is write-only and cannot be read back from AWS.
+func suppressLDAPServiceAccountPassword(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return old != ""
+	}
+
+	return old == "" && d.Id() != ""
+}
+
 func flattenConfiguration(config *types.Configurations) []interface{} {
 	if config == nil || config.Current == nil {
 		return []interface{}{}
@@ -1065,7 +1933,159 @@ func flattenConfiguration(config *types.Configurations) []interface{} {
 	return []interface{}{m}
 }
 
-func flattenBrokerInstances(instances []types.BrokerInstance) []interface{} {
+// brokerUpdatePending returns whether any pending broker change differs from its active value,
+// i.e. whether the broker must be rebooted for an update to take effect.
+func brokerUpdatePending(output *mq.DescribeBrokerOutput) bool {
+	if v := aws.ToString(output.PendingEngineVersion); v != "" && v != aws.ToString(output.EngineVersion) {
+		return true
+	}
+
+	if v := aws.ToString(output.PendingHostInstanceType); v != "" && v != aws.ToString(output.HostInstanceType) {
+		return true
+	}
+
+	if v := output.PendingAuthenticationStrategy; v != "" && v != output.AuthenticationStrategy {
+		return true
+	}
+
+	if v := flex.Set[string](output.PendingSecurityGroups); len(v) > 0 {
+		if len(v.Difference(output.SecurityGroups)) > 0 || len(flex.Set[string](output.SecurityGroups).Difference(v)) > 0 {
+			return true
+		}
+	}
+
+	if v := output.Configurations; v != nil && v.Pending != nil {
+		if v.Current == nil || aws.ToString(v.Pending.Id) != aws.ToString(v.Current.Id) || aws.ToInt32(v.Pending.Revision) != aws.ToInt32(v.Current.Revision) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configurationDrift returns the broker's active configuration revision and whether it differs from the configured revision.
+// A configured revision that is pending (e.g. awaiting a reboot) is not considered drift.
+func configurationDrift(cfg []interface{}, config *types.Configurations) (int32, bool) {
+	if len(cfg) < 1 || cfg[0] == nil || config == nil || config.Current == nil {
+		return 0, false
+	}
+
+	m := cfg[0].(map[string]interface{})
+	revision, ok := m["revision"].(int)
+	if !ok || revision < 1 {
+		return 0, false
+	}
+
+	if m["id"].(string) != aws.ToString(config.Current.Id) {
+		return 0, false
+	}
+
+	if config.Pending != nil && aws.ToInt32(config.Pending.Revision) == int32(revision) {
+		return 0, false
+	}
+
+	current := aws.ToInt32(config.Current.Revision)
+
+	return current, current != int32(revision)
+}
+
+// flattenClusterMemberCount returns the number of nodes of a CLUSTER_MULTI_AZ deployment, i.e. the number of broker
+// instances AWS reports for the broker. It returns false for any other deployment mode.
+func flattenClusterMemberCount(deploymentMode types.DeploymentMode, instances []types.BrokerInstance) (int, bool) {
+	if deploymentMode != types.DeploymentModeClusterMultiAz {
+		return 0, false
+	}
+
+	return len(instances), true
+}
+
+// findSubnetAvailabilityZonesByCIDRBlock returns the Availability Zone of each of the specified subnets, keyed by IPv4 CIDR block.
+func findSubnetAvailabilityZonesByCIDRBlock(ctx context.Context, conn *ec2.Client, subnetIDs []string) (map[string]string, error) {
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	}
+
+	m := make(map[string]string, len(subnetIDs))
+
+	pages := ec2.NewDescribeSubnetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Subnets {
+			m[aws.ToString(v.CidrBlock)] = aws.ToString(v.AvailabilityZone)
+		}
+	}
+
+	return m, nil
+}
+
+// instanceAvailabilityZonesFromState returns the availability_zone of each broker instance in state, keyed by the
+// single-address CIDR block of its IP address. An instance IP address stays in the same subnet while the broker's subnets
+// are unchanged, so its Availability Zone can be reused. It returns false if subnet_ids in state differs from the specified
+// subnet IDs or any of the specified instances has no Availability Zone in state.
+func instanceAvailabilityZonesFromState(d *schema.ResourceData, subnetIDs []string, instances []types.BrokerInstance) (map[string]string, bool) {
+	if len(instances) == 0 {
+		return nil, false
+	}
+
+	if v := d.Get("subnet_ids").(*schema.Set); !v.Equal(schema.NewSet(v.F, flex.FlattenStringValueList(subnetIDs))) {
+		return nil, false
+	}
+
+	azByIPAddress := make(map[string]string)
+	for _, v := range d.Get("instances").([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if az := tfMap["availability_zone"].(string); az != "" {
+				azByIPAddress[tfMap["ip_address"].(string)] = az
+			}
+		}
+	}
+
+	azByCIDRBlock := make(map[string]string, len(instances))
+	for _, instance := range instances {
+		ipAddress := aws.ToString(instance.IpAddress)
+		az, ok := azByIPAddress[ipAddress]
+		if !ok {
+			return nil, false
+		}
+
+		addr, err := netip.ParseAddr(ipAddress)
+		if err != nil {
+			return nil, false
+		}
+
+		azByCIDRBlock[netip.PrefixFrom(addr, addr.BitLen()).String()] = az
+	}
+
+	return azByCIDRBlock, true
+}
+
+// availabilityZoneForIPAddress returns the Availability Zone of the subnet whose CIDR block contains the specified IP address.
+// The broker instance API output does not report a subnet or Availability Zone, so this is resolved from the instance's IP address.
+func availabilityZoneForIPAddress(ipAddress string, azByCIDRBlock map[string]string) string {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return ""
+	}
+
+	for cidrBlock, az := range azByCIDRBlock {
+		if prefix, err := netip.ParsePrefix(cidrBlock); err == nil && prefix.Contains(addr) {
+			return az
+		}
+	}
+
+	return ""
+}
+
+func flattenBrokerInstances(instances []types.BrokerInstance, azByCIDRBlock map[string]string) []interface{} {
 	if len(instances) == 0 {
 		return []interface{}{}
 	}
@@ -1080,6 +2100,12 @@ func flattenBrokerInstances(instances []types.BrokerInstance) []interface{} {
 		}
 		if instance.IpAddress != nil {
 			m["ip_address"] = aws.ToString(instance.IpAddress)
+			if v := availabilityZoneForIPAddress(aws.ToString(instance.IpAddress), azByCIDRBlock); v != "" {
+				m["availability_zone"] = v
+			}
+		}
+		for k, v := range flattenEndpointsByProtocol(instance.Endpoints) {
+			m[k] = v
 		}
 		l[i] = m
 	}
@@ -1087,7 +2113,39 @@ func flattenBrokerInstances(instances []types.BrokerInstance) []interface{} {
 	return l
 }
 
-func flattenLogs(logs *types.LogsSummary) []interface{} {
+// endpointProtocolAttributes maps broker endpoint URL schemes to their instance attribute names.
+var endpointProtocolAttributes = map[string]string{
+	"amqp+ssl":  "amqp_endpoint",
+	"amqps":     "amqp_endpoint",
+	"mqtt+ssl":  "mqtt_endpoint",
+	"ssl":       "openwire_endpoint",
+	"stomp+ssl": "stomp_endpoint",
+	"wss":       "wss_endpoint",
+}
+
+// flattenEndpointsByProtocol groups a broker instance's endpoints by wire-level protocol.
+// Endpoints with an unrecognized scheme are ignored.
+func flattenEndpointsByProtocol(endpoints []string) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	for _, endpoint := range endpoints {
+		scheme, _, ok := strings.Cut(endpoint, "://")
+		if !ok {
+			continue
+		}
+
+		if k, ok := endpointProtocolAttributes[strings.ToLower(scheme)]; ok {
+			if _, ok := m[k]; !ok {
+				m[k] = endpoint
+			}
+		}
+	}
+
+	return m
+}
+
+// flattenLogs returns the logs configuration block. Audit logging isn't supported for RabbitMQ, so audit is omitted for that engine.
+func flattenLogs(engineType string, logs *types.LogsSummary) []interface{} {
 	if logs == nil {
 		return []interface{}{}
 	}
@@ -1098,7 +2156,7 @@ func flattenLogs(logs *types.LogsSummary) []interface{} {
 		m["general"] = aws.ToBool(logs.General)
 	}
 
-	if logs.Audit != nil {
+	if logs.Audit != nil && !strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)) {
 		m["audit"] = strconv.FormatBool(aws.ToBool(logs.Audit))
 	}
 
@@ -1118,6 +2176,7 @@ func expandLogs(engineType string, l []interface{}) *types.Logs {
 		logs.General = aws.Bool(v.(bool))
 	}
 
+	// An explicit false is sent so that audit logging can be disabled once enabled.
 	// When the engine type is "RabbitMQ", the parameter audit cannot be set at all.
 	if v, ok := m["audit"]; ok {
 		if v, null, _ := nullable.Bool(v.(string)).Value(); !null {
@@ -1224,3 +2283,28 @@ var ValidateBrokerName = validation.All(
 	validation.StringLenBetween(1, 50),
 	validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), ""),
 )
+
+var ldapHostNameRegexp = regexache.MustCompile(`^[0-9A-Za-z]([0-9A-Za-z.-]*[0-9A-Za-z])?$`)
+
+// validateBrokerLDAPHost validates that an LDAP server host is a host name or IPv4 address, optionally followed by :port.
+func validateBrokerLDAPHost(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	host, port, hasPort := strings.Cut(value, ":")
+	valid := ldapHostNameRegexp.MatchString(host)
+	if hasPort {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			valid = false
+		}
+	}
+
+	if !valid {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a host name, optionally followed by a port between 1 and 65535, e.g. ldap.example.com:636", k, value))
+	}
+
+	return
+}
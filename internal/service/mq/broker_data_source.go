@@ -49,6 +49,14 @@ func dataSourceBroker() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"broker_id"},
 			},
+			"broker_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"configuration": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -69,6 +77,10 @@ func dataSourceBroker() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"effective_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"encryption_options": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -102,6 +114,14 @@ func dataSourceBroker() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"amqp_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"console_url": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -115,6 +135,22 @@ func dataSourceBroker() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"mqtt_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"openwire_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stomp_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wss_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -207,6 +243,10 @@ func dataSourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"pending_authentication_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -226,6 +266,10 @@ func dataSourceBroker() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"update_pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"user": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -291,15 +335,29 @@ func dataSourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("auto_minor_version_upgrade", output.AutoMinorVersionUpgrade)
 	d.Set("broker_id", brokerID)
 	d.Set("broker_name", output.BrokerName)
+	d.Set("broker_state", output.BrokerState)
+	if v, ok := flattenClusterMemberCount(output.DeploymentMode, output.BrokerInstances); ok {
+		d.Set("cluster_member_count", v)
+	} else {
+		d.Set("cluster_member_count", nil)
+	}
 	d.Set("deployment_mode", output.DeploymentMode)
+	d.Set("effective_engine_version", output.EngineVersion)
 	d.Set("engine_type", output.EngineType)
 	d.Set("engine_version", output.EngineVersion)
 	d.Set("host_instance_type", output.HostInstanceType)
-	d.Set("instances", flattenBrokerInstances(output.BrokerInstances))
+
+	azByCIDRBlock, err := findSubnetAvailabilityZonesByCIDRBlock(ctx, meta.(*conns.AWSClient).EC2Client(ctx), output.SubnetIds)
+	if err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading MQ Broker (%s) subnets, instances availability_zone not set: %s", brokerID, err)
+	}
+	d.Set("instances", flattenBrokerInstances(output.BrokerInstances, azByCIDRBlock))
+	d.Set("pending_authentication_strategy", output.PendingAuthenticationStrategy)
 	d.Set("publicly_accessible", output.PubliclyAccessible)
 	d.Set("security_groups", output.SecurityGroups)
 	d.Set("storage_type", output.StorageType)
 	d.Set("subnet_ids", output.SubnetIds)
+	d.Set("update_pending", brokerUpdatePending(output))
 
 	if err := d.Set("configuration", flattenConfiguration(output.Configurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
@@ -318,7 +376,7 @@ func dataSourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
 	}
 
-	if err := d.Set("logs", flattenLogs(output.Logs)); err != nil {
+	if err := d.Set("logs", flattenLogs(string(output.EngineType), output.Logs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting logs: %s", err)
 	}
 
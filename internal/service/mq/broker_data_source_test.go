@@ -35,6 +35,7 @@ func TestAccMQBrokerDataSource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceByIdName, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(dataSourceByIdName, "broker_name", resourceName, "broker_name"),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "broker_state", "RUNNING"),
 					resource.TestCheckResourceAttrPair(dataSourceByIdName, "authentication_strategy", resourceName, "authentication_strategy"),
 					resource.TestCheckResourceAttrPair(dataSourceByIdName, "auto_minor_version_upgrade", resourceName, "auto_minor_version_upgrade"),
 					resource.TestCheckResourceAttrPair(dataSourceByIdName, "deployment_mode", resourceName, "deployment_mode"),
@@ -60,6 +61,11 @@ func TestAccMQBrokerDataSource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceByNameName, "broker_id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(dataSourceByNameName, "broker_name", resourceName, "broker_name"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "deployment_mode", resourceName, "deployment_mode"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "engine_type", resourceName, "engine_type"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "engine_version", resourceName, "engine_version"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "instances.#", resourceName, "instances.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "instances.0.console_url", resourceName, "instances.0.console_url"),
 				),
 			},
 		},
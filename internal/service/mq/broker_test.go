@@ -4,25 +4,39 @@
 package mq_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmq "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/maps"
 )
 
 func TestValidateBrokerName(t *testing.T) {
@@ -58,6 +72,45 @@ func TestValidateBrokerName(t *testing.T) {
 	}
 }
 
+func TestValidateBrokerLDAPHost(t *testing.T) {
+	t.Parallel()
+
+	validHosts := []string{
+		"ldap.example.com",
+		"ldap.example.com:636",
+		"my-ldap-01.example.com:389",
+		"10.0.0.1",
+		"10.0.0.1:636",
+		"localhost",
+	}
+	for _, v := range validHosts {
+		_, errors := tfmq.ValidateBrokerLDAPHost(v, "hosts.0")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid LDAP host: %q", v, errors)
+		}
+	}
+
+	invalidHosts := []string{
+		"",
+		"ldap://ldap.example.com",
+		"ldaps://ldap.example.com:636",
+		"ldap.example.com:",
+		"ldap.example.com:0",
+		"ldap.example.com:65536",
+		"ldap.example.com:port",
+		":636",
+		"ldap.example.com/path",
+		"ldap example.com",
+		"-ldap.example.com",
+	}
+	for _, v := range invalidHosts {
+		_, errors := tfmq.ValidateBrokerLDAPHost(v, "hosts.0")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid LDAP host", v)
+		}
+	}
+}
+
 func TestBrokerPasswordValidation(t *testing.T) {
 	t.Parallel()
 
@@ -238,182 +291,3295 @@ func TestDiffUsers(t *testing.T) {
 	}
 }
 
-const (
-	testAccBrokerVersionNewer = "5.17.6"  // before changing, check b/c must be valid on GovCloud
-	testAccBrokerVersionOlder = "5.16.7"  // before changing, check b/c must be valid on GovCloud
-	testAccRabbitVersion      = "3.11.20" // before changing, check b/c must be valid on GovCloud
-)
+func TestEngineVersionPatchUpgraded(t *testing.T) {
+	t.Parallel()
 
-func TestAccMQBroker_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
+	testCases := []struct {
+		configured string
+		running    string
+		want       bool
+	}{
+		{configured: "5.17.3", running: "5.17.6", want: true},
+		{configured: "3.11.20", running: "3.11.28", want: true},
+		{configured: "5.17.6", running: "5.17.6", want: false},
+		{configured: "5.17.6", running: "5.17.3", want: false},
+		{configured: "5.17.6", running: "5.18.3", want: false},
+		{configured: "3.11.20", running: "3.12.13", want: false},
+		{configured: "latest", running: "5.17.6", want: false},
 	}
 
-	var broker mq.DescribeBrokerOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_mq_broker.test"
+	for _, testCase := range testCases {
+		if got := tfmq.EngineVersionPatchUpgraded(testCase.configured, testCase.running); got != testCase.want {
+			t.Errorf("EngineVersionPatchUpgraded(%q, %q) = %t, want %t", testCase.configured, testCase.running, got, testCase.want)
+		}
+	}
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
-			testAccPreCheck(ctx, t)
+func TestEngineVersionMajorMinorMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		configured string
+		running    string
+		want       bool
+	}{
+		{configured: "3.13", running: "3.13.2", want: true},
+		{configured: "3.12", running: "3.13.2", want: false},
+		{configured: "3.1", running: "3.13.2", want: false},
+		{configured: "3.13.2", running: "3.13.2", want: false},
+		{configured: "3.13", running: "3.13", want: false},
+		{configured: "5.17.6", running: "5.17.6.1", want: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfmq.EngineVersionMajorMinorMatches(testCase.configured, testCase.running); got != testCase.want {
+			t.Errorf("EngineVersionMajorMinorMatches(%q, %q) = %t, want %t", testCase.configured, testCase.running, got, testCase.want)
+		}
+	}
+}
+
+func TestSuppressEngineVersionUpgraded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		autoMinorVersionUpgrade bool
+		engineType              string
+		old                     string
+		new                     string
+		want                    bool
+	}{
+		"upgraded": {
+			autoMinorVersionUpgrade: true,
+			old:                     "3.11.28",
+			new:                     "3.11.20",
+			want:                    true,
 		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
-					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
-					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.revision", regexache.MustCompile(`^[0-9]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "SINGLE_INSTANCE"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
-					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.day_of_week"),
-					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.time_of_day"),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "UTC"),
-					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
-					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "Test",
-						"password":       "TestTest1234",
-					}),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
-			},
+		"upgraded without auto minor version upgrade": {
+			old: "3.11.28",
+			new: "3.11.20",
 		},
-	})
-}
+		"unchanged": {
+			autoMinorVersionUpgrade: true,
+			old:                     "3.11.20",
+			new:                     "3.11.20",
+		},
+		"configured upgrade": {
+			autoMinorVersionUpgrade: true,
+			old:                     "3.11.20",
+			new:                     "3.11.28",
+		},
+		"minor version downgrade": {
+			autoMinorVersionUpgrade: true,
+			old:                     "3.12.13",
+			new:                     "3.11.20",
+		},
+		"create": {
+			autoMinorVersionUpgrade: true,
+			new:                     "3.11.20",
+		},
+		"RabbitMQ major minor": {
+			engineType: string(types.EngineTypeRabbitmq),
+			old:        "3.13.2",
+			new:        "3.13",
+			want:       true,
+		},
+		"RabbitMQ major minor mismatch": {
+			engineType: string(types.EngineTypeRabbitmq),
+			old:        "3.13.2",
+			new:        "3.12",
+		},
+		"ActiveMQ major minor": {
+			engineType: string(types.EngineTypeActivemq),
+			old:        "5.17.6",
+			new:        "5.17",
+		},
+	}
 
-func TestAccMQBroker_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfmq.ResourceBroker().SchemaMap(), map[string]interface{}{
+				"auto_minor_version_upgrade": testCase.autoMinorVersionUpgrade,
+				"engine_type":                testCase.engineType,
+			})
+
+			if got, want := tfmq.SuppressEngineVersionUpgraded("engine_version", testCase.old, testCase.new, d), testCase.want; got != want {
+				t.Errorf("unexpected result, got: %t, want: %t", got, want)
+			}
+		})
 	}
+}
 
-	var broker mq.DescribeBrokerOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_mq_broker.test"
+func TestSuppressLatestConfigurationRevision(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
-			testAccPreCheck(ctx, t)
+	testCases := map[string]struct {
+		configurationID string
+		old             string
+		new             string
+		want            bool
+	}{
+		"computed revision": {
+			old:  "2",
+			new:  "0",
+			want: true,
 		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmq.ResourceBroker(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
+		"computed revision empty": {
+			old:  "2",
+			new:  "",
+			want: true,
 		},
-	})
+		"explicit revision": {
+			old: "2",
+			new: "3",
+		},
+		"explicit revision unchanged": {
+			old: "2",
+			new: "2",
+		},
+		"explicit revision create": {
+			new: "1",
+		},
+		"computed revision create": {
+			new: "0",
+		},
+		"computed revision configuration changed": {
+			configurationID: "c-2",
+			old:             "2",
+			new:             "0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{}
+			if testCase.configurationID != "" {
+				raw["configuration"] = []interface{}{
+					map[string]interface{}{
+						"id": testCase.configurationID,
+					},
+				}
+			}
+			d := schema.TestResourceDataRaw(t, tfmq.ResourceBroker().SchemaMap(), raw)
+
+			if got, want := tfmq.SuppressLatestConfigurationRevision("configuration.0.revision", testCase.old, testCase.new, d), testCase.want; got != want {
+				t.Errorf("unexpected result, got: %t, want: %t", got, want)
+			}
+		})
+	}
 }
 
-func TestAccMQBroker_tags(t *testing.T) {
-	ctx := acctest.Context(t)
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
+func TestSuppressLDAPServiceAccountPassword(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id   string
+		old  string
+		new  string
+		want bool
+	}{
+		"create": {
+			new: "password1",
+		},
+		"unchanged": {
+			id:  "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			old: "password1",
+			new: "password1",
+		},
+		"changed": {
+			id:  "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			old: "password1",
+			new: "password2",
+		},
+		"omitted": {
+			id:   "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			old:  "password1",
+			want: true,
+		},
+		"imported": {
+			id:   "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			new:  "password1",
+			want: true,
+		},
 	}
 
-	var broker mq.DescribeBrokerOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_mq_broker.test"
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
-			testAccPreCheck(ctx, t)
+			d := schema.TestResourceDataRaw(t, tfmq.ResourceBroker().SchemaMap(), map[string]interface{}{})
+			d.SetId(testCase.id)
+
+			if got, want := tfmq.SuppressLDAPServiceAccountPassword("ldap_server_metadata.0.service_account_password", testCase.old, testCase.new, d), testCase.want; got != want {
+				t.Errorf("unexpected result, got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestReadBrokerEngineVersionUpgraded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		autoMinorVersionUpgrade bool
+		wantDiff                bool
+	}{
+		"auto minor version upgrade": {
+			autoMinorVersionUpgrade: true,
 		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccBrokerConfig_tags1(rName, testAccBrokerVersionNewer, "key1", "value1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
-			},
-			{
-				Config: testAccBrokerConfig_tags2(rName, testAccBrokerVersionNewer, "key1", "value1updated", "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
-			},
-			{
-				Config: testAccBrokerConfig_tags1(rName, testAccBrokerVersionNewer, "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
-			},
+		"no auto minor version upgrade": {
+			wantDiff: true,
 		},
-	})
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			// The broker is running a newer patch level than the one configured.
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				return http.StatusOK, map[string]any{
+					"autoMinorVersionUpgrade": testCase.autoMinorVersionUpgrade,
+					"brokerId":                "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+					"brokerName":              "test",
+					"brokerState":             string(types.BrokerStateRunning),
+					"deploymentMode":          string(types.DeploymentModeSingleInstance),
+					"engineType":              "ActiveMQ",
+					"engineVersion":           "5.17.8",
+					"hostInstanceType":        "mq.t3.micro",
+					"publiclyAccessible":      false,
+					"storageType":             string(types.BrokerStorageTypeEfs),
+				}
+			})
+
+			state := &terraformsdk.InstanceState{
+				ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+				Attributes: map[string]string{
+					"auto_minor_version_upgrade": strconv.FormatBool(testCase.autoMinorVersionUpgrade),
+					"broker_name":                "test",
+					"engine_type":                "ActiveMQ",
+					"engine_version":             "5.17.6",
+					"host_instance_type":         "mq.t3.micro",
+				},
+			}
+
+			d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(state, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diags := tfmq.ReadBroker(ctx, d, conn, nil); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get("engine_version").(string), "5.17.8"; got != want {
+				t.Errorf("engine_version = %q, want %q", got, want)
+			}
+
+			config := terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"auto_minor_version_upgrade": testCase.autoMinorVersionUpgrade,
+				"broker_name":                "test",
+				"engine_type":                "ActiveMQ",
+				"engine_version":             "5.17.6",
+				"host_instance_type":         "mq.t3.micro",
+			})
+
+			diff, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Diff(ctx, d.State(), config, nil, nil, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attr *terraformsdk.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes["engine_version"]
+			}
+
+			if got, want := attr != nil, testCase.wantDiff; got != want {
+				t.Fatalf("engine_version diff = %t, want %t", got, want)
+			}
+
+			if attr != nil {
+				if got, want := attr.Old, "5.17.8"; got != want {
+					t.Errorf("engine_version Old = %q, want %q", got, want)
+				}
+				if got, want := attr.New, "5.17.6"; got != want {
+					t.Errorf("engine_version New = %q, want %q", got, want)
+				}
+			}
+		})
+	}
 }
 
-func TestAccMQBroker_throughputOptimized(t *testing.T) {
+func TestReadBrokerInstanceAvailabilityZoneFromState(t *testing.T) {
+	t.Parallel()
+
 	ctx := acctest.Context(t)
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
+
+	conn := newMockClient(func(r *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{
+			"brokerId": "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			"brokerInstances": []map[string]any{
+				{"ipAddress": "10.0.1.10"},
+				{"ipAddress": "10.0.2.20"},
+			},
+			"brokerName":         "test",
+			"brokerState":        string(types.BrokerStateRunning),
+			"deploymentMode":     string(types.DeploymentModeActiveStandbyMultiAz),
+			"engineType":         "ActiveMQ",
+			"engineVersion":      "5.17.6",
+			"hostInstanceType":   "mq.m5.large",
+			"publiclyAccessible": false,
+			"storageType":        string(types.BrokerStorageTypeEfs),
+			"subnetIds":          []string{"subnet-1", "subnet-2"},
+		}
+	})
+
+	state := &terraformsdk.InstanceState{
+		ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+		Attributes: map[string]string{
+			"broker_name":                   "test",
+			"engine_type":                   "ActiveMQ",
+			"engine_version":                "5.17.6",
+			"host_instance_type":            "mq.m5.large",
+			"instances.#":                   "2",
+			"instances.0.availability_zone": "us-west-2a", //lintignore:AWSAT003
+			"instances.0.ip_address":        "10.0.2.20",
+			"instances.1.availability_zone": "us-west-2b", //lintignore:AWSAT003
+			"instances.1.ip_address":        "10.0.1.10",
+			"subnet_ids.#":                  "2",
+			"subnet_ids.0":                  "subnet-1",
+			"subnet_ids.1":                  "subnet-2",
+		},
+	}
+
+	d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(state, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// No EC2 client: the subnets and instance IP addresses are unchanged, so no subnets are described.
+	if diags := tfmq.ReadBroker(ctx, d, conn, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Get("instances.0.availability_zone").(string), "us-west-2b"; got != want { //lintignore:AWSAT003
+		t.Errorf("instances.0.availability_zone = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("instances.1.availability_zone").(string), "us-west-2a"; got != want { //lintignore:AWSAT003
+		t.Errorf("instances.1.availability_zone = %q, want %q", got, want)
+	}
+}
+
+func TestInstanceAvailabilityZonesFromState(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]string{
+		"instances.#":                   "2",
+		"instances.0.availability_zone": "us-west-2a", //lintignore:AWSAT003
+		"instances.0.ip_address":        "10.0.1.10",
+		"instances.1.availability_zone": "",
+		"instances.1.ip_address":        "10.0.2.20",
+		"subnet_ids.#":                  "2",
+		"subnet_ids.0":                  "subnet-1",
+		"subnet_ids.1":                  "subnet-2",
+	}
+
+	testCases := map[string]struct {
+		subnetIDs []string
+		instances []types.BrokerInstance
+		want      map[string]string
+		wantOK    bool
+	}{
+		"unchanged": {
+			subnetIDs: []string{"subnet-2", "subnet-1"},
+			instances: []types.BrokerInstance{{IpAddress: aws.String("10.0.1.10")}},
+			want: map[string]string{
+				"10.0.1.10/32": "us-west-2a", //lintignore:AWSAT003
+			},
+			wantOK: true,
+		},
+		"subnets changed": {
+			subnetIDs: []string{"subnet-1", "subnet-3"},
+			instances: []types.BrokerInstance{{IpAddress: aws.String("10.0.1.10")}},
+		},
+		"IP address changed": {
+			subnetIDs: []string{"subnet-1", "subnet-2"},
+			instances: []types.BrokerInstance{{IpAddress: aws.String("10.0.1.11")}},
+		},
+		"no Availability Zone in state": {
+			subnetIDs: []string{"subnet-1", "subnet-2"},
+			instances: []types.BrokerInstance{{IpAddress: aws.String("10.0.1.10")}, {IpAddress: aws.String("10.0.2.20")}},
+		},
+		"no instances": {
+			subnetIDs: []string{"subnet-1", "subnet-2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(&terraformsdk.InstanceState{ID: "test", Attributes: attributes}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, ok := tfmq.InstanceAvailabilityZonesFromState(d, testCase.subnetIDs, testCase.instances)
+
+			if want := testCase.wantOK; ok != want {
+				t.Fatalf("unexpected ok, got: %t, want: %t", ok, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandUpdateBrokerConfigurationInput(t *testing.T) {
+	t.Parallel()
+
+	state := &terraformsdk.InstanceState{
+		ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+		Attributes: map[string]string{
+			"configuration.#":          "1",
+			"configuration.0.id":       "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			"configuration.0.revision": "1",
+			"engine_type":              "ActiveMQ",
+			"engine_version":           "5.17.6",
+		},
+	}
+
+	testCases := map[string]struct {
+		diff              map[string]*terraformsdk.ResourceAttrDiff
+		rawConfig         cty.Value
+		wantEngineVersion *string
+		wantID            string
+		wantRevision      int32
+	}{
+		"configuration revision only": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"configuration.0.revision": {Old: "1", New: "2"},
+			},
+			wantID:       "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			wantRevision: 2,
+		},
+		"configuration id": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"configuration.0.id": {Old: "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9", New: "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9"},
+			},
+			wantID: "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9",
+		},
+		"configuration id and revision": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"configuration.0.id":       {Old: "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9", New: "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9"},
+				"configuration.0.revision": {Old: "1", New: "3"},
+			},
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"configuration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"id":       cty.StringVal("c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9"),
+					"revision": cty.NumberIntVal(3),
+				})}),
+			}),
+			wantID:       "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			wantRevision: 3,
+		},
+		"configuration id and unchanged revision": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"configuration.0.id": {Old: "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9", New: "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9"},
+			},
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"configuration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"id":       cty.StringVal("c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9"),
+					"revision": cty.NumberIntVal(1),
+				})}),
+			}),
+			wantID:       "c-9876a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			wantRevision: 1,
+		},
+		"engine version": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"engine_version": {Old: "5.17.6", New: "5.18.4"},
+			},
+			wantEngineVersion: aws.String("5.18.4"),
+			wantID:            "c-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+			wantRevision:      1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(state, &terraformsdk.InstanceDiff{Attributes: testCase.diff, RawConfig: testCase.rawConfig})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			input := tfmq.ExpandUpdateBrokerConfigurationInput(d)
+
+			if got, want := aws.ToString(input.BrokerId), state.ID; got != want {
+				t.Errorf("BrokerId = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(input.EngineVersion, testCase.wantEngineVersion); diff != "" {
+				t.Errorf("unexpected EngineVersion diff (+wanted, -got): %s", diff)
+			}
+			if input.Configuration == nil {
+				t.Fatal("Configuration is nil")
+			}
+			if got, want := aws.ToString(input.Configuration.Id), testCase.wantID; got != want {
+				t.Errorf("Configuration.Id = %q, want %q", got, want)
+			}
+			if got, want := aws.ToInt32(input.Configuration.Revision), testCase.wantRevision; got != want {
+				t.Errorf("Configuration.Revision = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerHostInstanceTypeDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	// AWS has migrated the broker to a different instance type than the one configured.
+	state := &terraformsdk.InstanceState{
+		ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+		Attributes: map[string]string{
+			"broker_name":         "test",
+			"deployment_mode":     string(types.DeploymentModeSingleInstance),
+			"engine_type":         "ActiveMQ",
+			"engine_version":      "5.17.6",
+			"host_instance_type":  "mq.m5.large",
+			"publicly_accessible": "false",
+			"storage_type":        "efs",
+			"subnet_ids.#":        "1",
+			"subnet_ids.0":        "subnet-12345678",
+		},
+	}
+	config := terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+		"broker_name":        "test",
+		"engine_type":        "ActiveMQ",
+		"engine_version":     "5.17.6",
+		"host_instance_type": "mq.t3.micro",
+	})
+
+	diff, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Diff(ctx, state, config, nil, nil, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil {
+		t.Fatal("expected diff")
+	}
+
+	if diff.RequiresNew() {
+		t.Error("expected in-place update, got replacement")
+	}
+
+	attr, ok := diff.Attributes["host_instance_type"]
+	if !ok {
+		t.Fatal("expected host_instance_type diff")
+	}
+
+	if got, want := attr.Old, "mq.m5.large"; got != want {
+		t.Errorf("host_instance_type Old = %q, want %q", got, want)
+	}
+	if got, want := attr.New, "mq.t3.micro"; got != want {
+		t.Errorf("host_instance_type New = %q, want %q", got, want)
+	}
+	if attr.RequiresNew {
+		t.Error("host_instance_type requires replacement")
+	}
+}
+
+func TestValidateBrokerEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	conn := newMockClient(func(r *http.Request) (int, any) {
+		return http.StatusOK, map[string]any{
+			"brokerEngineTypes": []map[string]any{
+				{
+					"engineType": string(types.EngineTypeRabbitmq),
+					"engineVersions": []map[string]any{
+						{"name": "3.11.28"},
+						{"name": "3.12.13"},
+						{"name": "3.13"},
+					},
+				},
+			},
+		}
+	})
+
+	engineVersions, err := tfmq.FindBrokerEngineVersions(ctx, conn, string(types.EngineTypeRabbitmq))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		engineVersion string
+		wantErr       bool
+	}{
+		"supported":             {engineVersion: "3.12.13"},
+		"supported major minor": {engineVersion: "3.11"},
+		"supported no patch":    {engineVersion: "3.13"},
+		"dropped":               {engineVersion: "3.8.34", wantErr: true},
+		"prefix only":           {engineVersion: "3.1", wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerEngineVersion(testCase.engineVersion, engineVersions)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err != nil {
+				if got, want := err.Error(), "supported versions are: 3.11.28, 3.12.13, 3.13"; !strings.Contains(got, want) {
+					t.Errorf("unexpected error, got: %q, want to contain: %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateBrokerHostInstanceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		hostInstanceType string
+		engineType       string
+		deploymentMode   string
+		wantErr          string
+	}{
+		"upgrade from micro": {
+			hostInstanceType: "mq.m5.large",
+			engineType:       "ActiveMQ",
+			deploymentMode:   string(types.DeploymentModeActiveStandbyMultiAz),
+		},
+		"micro single instance": {
+			hostInstanceType: "mq.t3.micro",
+			engineType:       "RabbitMQ",
+			deploymentMode:   string(types.DeploymentModeSingleInstance),
+		},
+		"micro active standby": {
+			hostInstanceType: "mq.t2.micro",
+			engineType:       "ActiveMQ",
+			deploymentMode:   string(types.DeploymentModeActiveStandbyMultiAz),
+		},
+		"micro unsupported deployment mode": {
+			hostInstanceType: "mq.t3.micro",
+			engineType:       "ActiveMQ",
+			deploymentMode:   string(types.DeploymentModeActiveStandbyMultiAz),
+			wantErr:          "host_instance_type: mq.t3.micro is not supported for ACTIVE_STANDBY_MULTI_AZ deployments, supported deployment modes are: SINGLE_INSTANCE",
+		},
+		"micro unsupported engine": {
+			hostInstanceType: "mq.t2.micro",
+			engineType:       "RabbitMQ",
+			deploymentMode:   string(types.DeploymentModeSingleInstance),
+			wantErr:          "host_instance_type: mq.t2.micro is not supported by RabbitMQ",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerHostInstanceType(testCase.hostInstanceType, testCase.engineType, testCase.deploymentMode)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateBrokerStorageType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		storageType      string
+		engineType       string
+		hostInstanceType string
+		wantErr          string
+	}{
+		"not set": {
+			engineType:       "RabbitMQ",
+			hostInstanceType: "mq.t3.micro",
+		},
+		"ActiveMQ EFS micro": {
+			storageType:      "efs",
+			engineType:       "ActiveMQ",
+			hostInstanceType: "mq.t3.micro",
+		},
+		"ActiveMQ EBS m5": {
+			storageType:      "ebs",
+			engineType:       "ActiveMQ",
+			hostInstanceType: "mq.m5.large",
+		},
+		"ActiveMQ EBS micro": {
+			storageType:      "ebs",
+			engineType:       "ActiveMQ",
+			hostInstanceType: "mq.t3.micro",
+			wantErr:          "storage_type: ebs is not supported by host_instance_type mq.t3.micro, only the mq.m5 family supports EBS for ActiveMQ",
+		},
+		"RabbitMQ EBS micro": {
+			storageType:      "EBS",
+			engineType:       "RabbitMQ",
+			hostInstanceType: "mq.t3.micro",
+		},
+		"RabbitMQ EFS": {
+			storageType:      "efs",
+			engineType:       "RabbitMQ",
+			hostInstanceType: "mq.m5.large",
+			wantErr:          "storage_type: efs is not supported by RabbitMQ, only EBS is supported",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerStorageType(testCase.storageType, testCase.engineType, testCase.hostInstanceType)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerStorageTypeCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	suppress := tfmq.ResourceBroker().SchemaMap()["storage_type"].DiffSuppressFunc
+
+	if !suppress("storage_type", "EFS", "efs", nil) {
+		t.Error("expected storage_type EFS -> efs to be suppressed")
+	}
+
+	if suppress("storage_type", "EFS", "ebs", nil) {
+		t.Error("expected storage_type EFS -> ebs not to be suppressed")
+	}
+}
+
+func TestValidateBrokerSubnetIDs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deploymentMode string
+		n              int
+		wantErr        bool
+	}{
+		"single instance":                   {deploymentMode: string(types.DeploymentModeSingleInstance), n: 1},
+		"single instance too many":          {deploymentMode: string(types.DeploymentModeSingleInstance), n: 2, wantErr: true},
+		"single instance lower case":        {deploymentMode: "single_instance", n: 2, wantErr: true},
+		"active standby":                    {deploymentMode: string(types.DeploymentModeActiveStandbyMultiAz), n: 2},
+		"active standby too few":            {deploymentMode: string(types.DeploymentModeActiveStandbyMultiAz), n: 1, wantErr: true},
+		"active standby too many":           {deploymentMode: string(types.DeploymentModeActiveStandbyMultiAz), n: 3, wantErr: true},
+		"cluster multi az one subnet":       {deploymentMode: string(types.DeploymentModeClusterMultiAz), n: 1},
+		"cluster multi az multiple subnets": {deploymentMode: string(types.DeploymentModeClusterMultiAz), n: 3},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerSubnetIDs(testCase.deploymentMode, testCase.n)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateBrokerDeploymentModeTransition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldMode string
+		newMode string
+		n       int
+		wantErr string
+	}{
+		"single instance to active standby one subnet": {
+			oldMode: string(types.DeploymentModeSingleInstance),
+			newMode: string(types.DeploymentModeActiveStandbyMultiAz),
+			n:       1,
+			wantErr: "changing deployment_mode from SINGLE_INSTANCE to ACTIVE_STANDBY_MULTI_AZ replaces the broker, and the replacement broker's subnets must suit the new deployment mode: subnet_ids: ACTIVE_STANDBY_MULTI_AZ deployment mode requires exactly 2 subnet(s), got 1",
+		},
+		"single instance to active standby two subnets": {
+			oldMode: string(types.DeploymentModeSingleInstance),
+			newMode: string(types.DeploymentModeActiveStandbyMultiAz),
+			n:       2,
+		},
+		"active standby to single instance two subnets": {
+			oldMode: string(types.DeploymentModeActiveStandbyMultiAz),
+			newMode: string(types.DeploymentModeSingleInstance),
+			n:       2,
+			wantErr: "changing deployment_mode from ACTIVE_STANDBY_MULTI_AZ to SINGLE_INSTANCE replaces the broker, and the replacement broker's subnets must suit the new deployment mode: subnet_ids: SINGLE_INSTANCE deployment mode requires exactly 1 subnet(s), got 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerDeploymentModeTransition(testCase.oldMode, testCase.newMode, testCase.n)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateBrokerReplicationUsers(t *testing.T) {
+	t.Parallel()
+
+	replicationUser := map[string]interface{}{
+		"username":         "Test",
+		"password":         "TestTest1234",
+		"replication_user": true,
+	}
+	user := map[string]interface{}{
+		"username":         "Test",
+		"password":         "TestTest1234",
+		"replication_user": false,
+	}
+
+	testCases := map[string]struct {
+		engineType string
+		users      []interface{}
+		wantErr    bool
+	}{
+		"ActiveMQ replication user": {
+			engineType: string(types.EngineTypeActivemq),
+			users:      []interface{}{replicationUser},
+		},
+		"ActiveMQ lowercase replication user": {
+			engineType: "activemq",
+			users:      []interface{}{replicationUser},
+		},
+		"RabbitMQ replication user": {
+			engineType: string(types.EngineTypeRabbitmq),
+			users:      []interface{}{user, replicationUser},
+			wantErr:    true,
+		},
+		"RabbitMQ no replication user": {
+			engineType: string(types.EngineTypeRabbitmq),
+			users:      []interface{}{user},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidateBrokerReplicationUsers(testCase.engineType, testCase.users)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCustomizeDiffReplicationUser(t *testing.T) {
+	t.Parallel()
+
+	r := tfmq.ResourceBroker()
+	userType := r.CoreConfigSchema().ImpliedType().AttributeType("user")
+	users := cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"console_access":   cty.False,
+		"groups":           cty.NullVal(cty.Set(cty.String)),
+		"password":         cty.StringVal("TestTest1234"),
+		"replication_user": cty.True,
+		"username":         cty.StringVal("Test"),
+	})})
+
+	testCases := map[string]struct {
+		engineType cty.Value
+		users      cty.Value
+		wantErr    bool
+	}{
+		"RabbitMQ replication user": {
+			engineType: cty.StringVal(string(types.EngineTypeRabbitmq)),
+			users:      users,
+			wantErr:    true,
+		},
+		"unknown engine type": {
+			engineType: cty.UnknownVal(cty.String),
+			users:      users,
+		},
+		"unknown users": {
+			engineType: cty.StringVal(string(types.EngineTypeRabbitmq)),
+			users:      cty.UnknownVal(userType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			config := terraformsdk.NewResourceConfigShimmed(testBrokerRawConfig(r, map[string]cty.Value{
+				"broker_name":        cty.StringVal("test"),
+				"engine_type":        testCase.engineType,
+				"engine_version":     cty.StringVal("3.13"),
+				"host_instance_type": cty.StringVal("mq.m5.large"),
+				"user":               testCase.users,
+			}), r.CoreConfigSchema())
+
+			_, err := schema.InternalMap(r.SchemaMap()).Diff(ctx, nil, config, tfmq.CustomizeDiffReplicationUser, nil, true)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestExpandUsers(t *testing.T) {
+	t.Parallel()
+
+	cfg := []interface{}{
+		map[string]interface{}{
+			"console_access":   true,
+			"groups":           schema.NewSet(schema.HashString, []interface{}{"admin"}),
+			"password":         "TestTest1234",
+			"replication_user": true,
+			"username":         "Test",
+		},
+	}
+
+	testCases := map[string]struct {
+		engineType string
+		want       []types.User
+	}{
+		"ActiveMQ": {
+			engineType: string(types.EngineTypeActivemq),
+			want: []types.User{{
+				ConsoleAccess:   aws.Bool(true),
+				Groups:          []string{"admin"},
+				Password:        aws.String("TestTest1234"),
+				ReplicationUser: aws.Bool(true),
+				Username:        aws.String("Test"),
+			}},
+		},
+		"RabbitMQ": {
+			engineType: string(types.EngineTypeRabbitmq),
+			want: []types.User{{
+				ConsoleAccess: aws.Bool(true),
+				Groups:        []string{"admin"},
+				Password:      aws.String("TestTest1234"),
+				Username:      aws.String("Test"),
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.ExpandUsers(testCase.engineType, cfg)
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(types.User{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupsWithEmptySubnetIDs(t *testing.T) {
+	t.Parallel()
+
+	securityGroups := cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")})
+	subnetIDs := cty.SetVal([]cty.Value{cty.StringVal("subnet-12345678")})
+
+	testCases := map[string]struct {
+		securityGroups cty.Value
+		subnetIDs      cty.Value
+		want           bool
+	}{
+		"security groups and subnets": {
+			securityGroups: securityGroups,
+			subnetIDs:      subnetIDs,
+		},
+		"security groups and no subnets": {
+			securityGroups: securityGroups,
+			subnetIDs:      cty.NullVal(cty.Set(cty.String)),
+		},
+		"security groups and empty subnets": {
+			securityGroups: securityGroups,
+			subnetIDs:      cty.SetValEmpty(cty.String),
+			want:           true,
+		},
+		"no security groups and empty subnets": {
+			securityGroups: cty.NullVal(cty.Set(cty.String)),
+			subnetIDs:      cty.SetValEmpty(cty.String),
+		},
+		"empty security groups and empty subnets": {
+			securityGroups: cty.SetValEmpty(cty.String),
+			subnetIDs:      cty.SetValEmpty(cty.String),
+		},
+		"unknown security groups and empty subnets": {
+			securityGroups: cty.UnknownVal(cty.Set(cty.String)),
+			subnetIDs:      cty.SetValEmpty(cty.String),
+		},
+		"security groups and unknown subnets": {
+			securityGroups: securityGroups,
+			subnetIDs:      cty.UnknownVal(cty.Set(cty.String)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfmq.SecurityGroupsWithEmptySubnetIDs(testCase.securityGroups, testCase.subnetIDs), testCase.want; got != want {
+				t.Errorf("unexpected result, got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerSecurityGroupsMaxItems(t *testing.T) {
+	t.Parallel()
+
+	// The MQ API accepts at most 5 security groups per broker.
+	sm := schema.InternalMap(map[string]*schema.Schema{
+		"security_groups": tfmq.ResourceBroker().SchemaMap()["security_groups"],
+	})
+
+	testCases := map[string]struct {
+		n       int
+		wantErr bool
+	}{
+		"one":      {n: 1},
+		"maximum":  {n: 5},
+		"too many": {n: 6, wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			securityGroups := make([]interface{}, 0, testCase.n)
+			for i := 0; i < testCase.n; i++ {
+				securityGroups = append(securityGroups, fmt.Sprintf("sg-%017d", i))
+			}
+
+			diags := sm.Validate(terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"security_groups": securityGroups,
+			}))
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestBrokerRabbitMQUsersDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineType string
+		user       map[string]interface{}
+		wantDiff   bool
+		wantErr    string
+	}{
+		"RabbitMQ password changed": {
+			engineType: "RabbitMQ",
+			user: map[string]interface{}{
+				"password": "TestTest5678",
+				"username": "Test",
+			},
+		},
+		"RabbitMQ username changed": {
+			engineType: "RabbitMQ",
+			user: map[string]interface{}{
+				"password": "TestTest1234",
+				"username": "Test2",
+			},
+			wantErr: "user: Can not be changed for an existing RabbitMQ broker",
+		},
+		"RabbitMQ unchanged": {
+			engineType: "RabbitMQ",
+			user: map[string]interface{}{
+				"password": "TestTest1234",
+				"username": "Test",
+			},
+		},
+		"ActiveMQ password changed": {
+			engineType: "ActiveMQ",
+			user: map[string]interface{}{
+				"password": "TestTest5678",
+				"username": "Test",
+			},
+			wantDiff: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			state := &terraformsdk.InstanceState{
+				ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+				Attributes: map[string]string{
+					"arn":                     "arn:aws:mq:us-west-2:123456789012:broker:test:b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+					"broker_name":             "test",
+					"deployment_mode":         string(types.DeploymentModeSingleInstance),
+					"engine_type":             testCase.engineType,
+					"engine_version":          "3.13",
+					"host_instance_type":      "mq.m5.large",
+					"publicly_accessible":     "false",
+					"storage_type":            "ebs",
+					"subnet_ids.#":            "1",
+					"subnet_ids.0":            "subnet-12345678",
+					"user.#":                  "1",
+					"user.0.console_access":   "false",
+					"user.0.groups.#":         "0",
+					"user.0.password":         "TestTest1234",
+					"user.0.replication_user": "false",
+					"user.0.username":         "Test",
+				},
+			}
+			config := terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"broker_name":        "test",
+				"engine_type":        testCase.engineType,
+				"engine_version":     "3.13",
+				"host_instance_type": "mq.m5.large",
+				"user":               []interface{}{testCase.user},
+			})
+
+			diff, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Diff(ctx, state, config, tfmq.CustomizeDiffRabbitMQUsers, nil, true)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("error = %v, want to contain %q", err, testCase.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got bool
+			if diff != nil {
+				for k := range diff.Attributes {
+					if strings.HasPrefix(k, "user.") {
+						got = true
+					}
+				}
+			}
+
+			if want := testCase.wantDiff; got != want {
+				t.Errorf("user diff = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerRabbitMQLogsNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	logsType := cty.Object(map[string]cty.Type{
+		"audit":   cty.String,
+		"general": cty.Bool,
+	})
+
+	testCases := map[string]struct {
+		engineType string
+		general    string
+		config     map[string]interface{}
+		rawLogs    cty.Value
+		wantDiffs  bool
+	}{
+		"omitted general false": {
+			engineType: "RabbitMQ",
+			general:    "false",
+			rawLogs:    cty.ListValEmpty(logsType),
+		},
+		"omitted general true": {
+			engineType: "RabbitMQ",
+			general:    "true",
+			rawLogs:    cty.ListValEmpty(logsType),
+			wantDiffs:  true,
+		},
+		"ActiveMQ omitted general true": {
+			engineType: "ActiveMQ",
+			general:    "true",
+			rawLogs:    cty.ListValEmpty(logsType),
+			wantDiffs:  true,
+		},
+		"configured general false": {
+			engineType: "RabbitMQ",
+			general:    "true",
+			config: map[string]interface{}{
+				"logs": []interface{}{map[string]interface{}{"general": false}},
+			},
+			rawLogs: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"audit":   cty.NullVal(cty.String),
+				"general": cty.False,
+			})}),
+			wantDiffs: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &terraformsdk.InstanceState{
+				ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+				Attributes: map[string]string{
+					"broker_name":        "test",
+					"engine_type":        testCase.engineType,
+					"engine_version":     "3.11.20",
+					"host_instance_type": "mq.t3.micro",
+					"logs.#":             "1",
+					"logs.0.general":     testCase.general,
+				},
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"logs": testCase.rawLogs,
+				}),
+			}
+			raw := map[string]interface{}{
+				"broker_name":        "test",
+				"engine_type":        testCase.engineType,
+				"engine_version":     "3.11.20",
+				"host_instance_type": "mq.t3.micro",
+			}
+			for k, v := range testCase.config {
+				raw[k] = v
+			}
+
+			diff, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Diff(ctx, state, terraformsdk.NewResourceConfigRaw(raw), nil, nil, true)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			if diff != nil {
+				for k := range diff.Attributes {
+					if strings.HasPrefix(k, "logs.") {
+						got = append(got, k)
+					}
+				}
+			}
+
+			if gotDiffs := len(got) > 0; gotDiffs != testCase.wantDiffs {
+				t.Errorf("logs diffs = %v, want diffs %t", got, testCase.wantDiffs)
+			}
+
+			if testCase.wantDiffs {
+				if attr := diff.Attributes["logs.0.general"]; attr == nil || attr.New != "false" {
+					t.Errorf("logs.0.general diff = %#v, want general to be planned false", attr)
+				}
+			}
+		})
+	}
+}
+
+func TestBrokerRebootWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		applyImmediately bool
+		changed          []string
+		want             string
+	}{
+		"host instance type deferred": {
+			changed: []string{"host_instance_type"},
+			want:    "MQ Broker (b-1234) changes to host_instance_type require a reboot and apply_immediately is false, so they take effect in the next maintenance window",
+		},
+		"host instance type applied immediately": {
+			applyImmediately: true,
+			changed:          []string{"host_instance_type"},
+		},
+		"multiple attributes deferred": {
+			changed: []string{"engine_version", "logs", "security_groups"},
+			want:    "MQ Broker (b-1234) changes to engine_version, logs require a reboot and apply_immediately is false, so they take effect in the next maintenance window",
+		},
+		"no reboot required": {
+			changed: []string{"security_groups", "tags"},
+		},
+		"users updated": {
+			changed: []string{"user"},
+			want:    "MQ Broker (b-1234) changes to user require a reboot and apply_immediately is false, so they take effect in the next maintenance window",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hasChange := func(k string) bool {
+				for _, v := range testCase.changed {
+					if v == k {
+						return true
+					}
+				}
+				return false
+			}
+
+			if got, want := tfmq.BrokerRebootWarning("b-1234", testCase.applyImmediately, hasChange), testCase.want; got != want {
+				t.Errorf("warning = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerCustomizeDiffSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	r := tfmq.ResourceBroker()
+
+	testCases := map[string]struct {
+		config    map[string]interface{}
+		rawConfig map[string]cty.Value
+		wantErr   string
+	}{
+		"security groups and no subnets": {
+			config: map[string]interface{}{
+				"security_groups": []interface{}{"sg-12345678"},
+			},
+			rawConfig: map[string]cty.Value{
+				"security_groups": cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")}),
+			},
+		},
+		"security groups and empty subnets": {
+			config: map[string]interface{}{
+				"security_groups": []interface{}{"sg-12345678"},
+				"subnet_ids":      []interface{}{},
+			},
+			rawConfig: map[string]cty.Value{
+				"security_groups": cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")}),
+				"subnet_ids":      cty.SetValEmpty(cty.String),
+			},
+			wantErr: "subnet_ids: must not be empty when security_groups is set",
+		},
+		"security groups and subnets": {
+			config: map[string]interface{}{
+				"security_groups": []interface{}{"sg-12345678"},
+				"subnet_ids":      []interface{}{"subnet-12345678"},
+			},
+			rawConfig: map[string]cty.Value{
+				"security_groups": cty.SetVal([]cty.Value{cty.StringVal("sg-12345678")}),
+				"subnet_ids":      cty.SetVal([]cty.Value{cty.StringVal("subnet-12345678")}),
+			},
+		},
+		"unknown security groups and empty subnets": {
+			config: map[string]interface{}{
+				"subnet_ids": []interface{}{},
+			},
+			rawConfig: map[string]cty.Value{
+				"security_groups": cty.UnknownVal(cty.Set(cty.String)),
+				"subnet_ids":      cty.SetValEmpty(cty.String),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			rawConfig := testBrokerRawConfig(r, testCase.rawConfig)
+			state := &terraformsdk.InstanceState{
+				RawConfig: rawConfig,
+				RawPlan:   rawConfig,
+			}
+			config := map[string]interface{}{
+				"broker_name":        "test",
+				"deployment_mode":    string(types.DeploymentModeClusterMultiAz),
+				"engine_type":        "RabbitMQ",
+				"engine_version":     "3.11.20",
+				"host_instance_type": "mq.m5.large",
+			}
+			maps.Copy(config, testCase.config)
+
+			_, err := schema.InternalMap(r.SchemaMap()).Diff(ctx, state, terraformsdk.NewResourceConfigRaw(config), tfmq.CustomizeDiffSecurityGroups, &conns.AWSClient{}, true)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExpandLogs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineType string
+		tfList     []interface{}
+		want       *types.Logs
+	}{
+		"no logs": {
+			engineType: string(types.EngineTypeActivemq),
+		},
+		"ActiveMQ audit unset": {
+			engineType: string(types.EngineTypeActivemq),
+			tfList:     []interface{}{map[string]interface{}{"audit": "", "general": true}},
+			want:       &types.Logs{General: aws.Bool(true)},
+		},
+		"ActiveMQ audit enabled": {
+			engineType: string(types.EngineTypeActivemq),
+			tfList:     []interface{}{map[string]interface{}{"audit": "true", "general": true}},
+			want:       &types.Logs{Audit: aws.Bool(true), General: aws.Bool(true)},
+		},
+		"ActiveMQ audit disabled": {
+			engineType: string(types.EngineTypeActivemq),
+			tfList:     []interface{}{map[string]interface{}{"audit": "false", "general": true}},
+			want:       &types.Logs{Audit: aws.Bool(false), General: aws.Bool(true)},
+		},
+		"RabbitMQ audit disabled": {
+			engineType: string(types.EngineTypeRabbitmq),
+			tfList:     []interface{}{map[string]interface{}{"audit": "false", "general": true}},
+			want:       &types.Logs{General: aws.Bool(true)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.ExpandLogs(testCase.engineType, testCase.tfList)
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(types.Logs{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenLogs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineType string
+		apiObject  *types.LogsSummary
+		want       []interface{}
+	}{
+		"no logs": {
+			engineType: string(types.EngineTypeActivemq),
+			want:       []interface{}{},
+		},
+		"ActiveMQ": {
+			engineType: string(types.EngineTypeActivemq),
+			apiObject:  &types.LogsSummary{Audit: aws.Bool(true), General: aws.Bool(true)},
+			want:       []interface{}{map[string]interface{}{"audit": "true", "general": true}},
+		},
+		"ActiveMQ audit unset": {
+			engineType: string(types.EngineTypeActivemq),
+			apiObject:  &types.LogsSummary{General: aws.Bool(false)},
+			want:       []interface{}{map[string]interface{}{"general": false}},
+		},
+		"RabbitMQ": {
+			engineType: string(types.EngineTypeRabbitmq),
+			apiObject:  &types.LogsSummary{Audit: aws.Bool(false), General: aws.Bool(true)},
+			want:       []interface{}{map[string]interface{}{"general": true}},
+		},
+		"RabbitMQ lower case": {
+			engineType: "rabbitmq",
+			apiObject:  &types.LogsSummary{Audit: aws.Bool(false), General: aws.Bool(true)},
+			want:       []interface{}{map[string]interface{}{"general": true}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.FlattenLogs(testCase.engineType, testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenClusterMemberCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deploymentMode types.DeploymentMode
+		instances      []types.BrokerInstance
+		want           int
+		wantOK         bool
+	}{
+		"single instance": {
+			deploymentMode: types.DeploymentModeSingleInstance,
+			instances:      []types.BrokerInstance{{IpAddress: aws.String("10.0.0.1")}},
+		},
+		"active standby": {
+			deploymentMode: types.DeploymentModeActiveStandbyMultiAz,
+			instances:      []types.BrokerInstance{{IpAddress: aws.String("10.0.0.1")}, {IpAddress: aws.String("10.0.1.1")}},
+		},
+		"cluster": {
+			deploymentMode: types.DeploymentModeClusterMultiAz,
+			instances:      []types.BrokerInstance{{IpAddress: aws.String("10.0.0.1")}, {IpAddress: aws.String("10.0.1.1")}, {IpAddress: aws.String("10.0.2.1")}},
+			want:           3,
+			wantOK:         true,
+		},
+		"cluster no instances": {
+			deploymentMode: types.DeploymentModeClusterMultiAz,
+			wantOK:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tfmq.FlattenClusterMemberCount(testCase.deploymentMode, testCase.instances)
+
+			if want := testCase.wantOK; ok != want {
+				t.Fatalf("unexpected ok, got: %t, want: %t", ok, want)
+			}
+
+			if want := testCase.want; got != want {
+				t.Errorf("unexpected count, got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFlattenEndpointsByProtocol(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		endpoints []string
+		want      map[string]interface{}
+	}{
+		"no endpoints": {
+			want: map[string]interface{}{},
+		},
+		"ActiveMQ": {
+			endpoints: []string{
+				"ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61617",       //lintignore:AWSAT003
+				"amqp+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:5671",   //lintignore:AWSAT003
+				"stomp+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61614", //lintignore:AWSAT003
+				"mqtt+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:8883",   //lintignore:AWSAT003
+				"wss://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61619",       //lintignore:AWSAT003
+			},
+			want: map[string]interface{}{
+				"amqp_endpoint":     "amqp+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:5671",   //lintignore:AWSAT003
+				"mqtt_endpoint":     "mqtt+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:8883",   //lintignore:AWSAT003
+				"openwire_endpoint": "ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61617",       //lintignore:AWSAT003
+				"stomp_endpoint":    "stomp+ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61614", //lintignore:AWSAT003
+				"wss_endpoint":      "wss://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61619",       //lintignore:AWSAT003
+			},
+		},
+		"RabbitMQ": {
+			endpoints: []string{
+				"amqps://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9.mq.us-west-2.amazonaws.com:5671", //lintignore:AWSAT003
+			},
+			want: map[string]interface{}{
+				"amqp_endpoint": "amqps://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9.mq.us-west-2.amazonaws.com:5671", //lintignore:AWSAT003
+			},
+		},
+		"unrecognized scheme": {
+			endpoints: []string{
+				"https://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9.mq.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"not-a-url",
+			},
+			want: map[string]interface{}{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.FlattenEndpointsByProtocol(testCase.endpoints)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenBrokerInstances(t *testing.T) {
+	t.Parallel()
+
+	azByCIDRBlock := map[string]string{
+		"10.0.1.0/24": "us-west-2a", //lintignore:AWSAT003
+		"10.0.2.0/24": "us-west-2b", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		instances     []types.BrokerInstance
+		azByCIDRBlock map[string]string
+		want          []interface{}
+	}{
+		"no instances": {
+			azByCIDRBlock: azByCIDRBlock,
+			want:          []interface{}{},
+		},
+		"active standby": {
+			instances: []types.BrokerInstance{
+				{
+					ConsoleURL: aws.String("https://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:8162"), //lintignore:AWSAT003
+					Endpoints:  []string{"ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61617"},    //lintignore:AWSAT003
+					IpAddress:  aws.String("10.0.1.10"),
+				},
+				{
+					ConsoleURL: aws.String("https://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-2.mq.us-west-2.amazonaws.com:8162"), //lintignore:AWSAT003
+					Endpoints:  []string{"ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-2.mq.us-west-2.amazonaws.com:61617"},    //lintignore:AWSAT003
+					IpAddress:  aws.String("10.0.2.20"),
+				},
+			},
+			azByCIDRBlock: azByCIDRBlock,
+			want: []interface{}{
+				map[string]interface{}{
+					"availability_zone": "us-west-2a",                                                                                //lintignore:AWSAT003
+					"console_url":       "https://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:8162",          //lintignore:AWSAT003
+					"endpoints":         []string{"ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61617"}, //lintignore:AWSAT003
+					"ip_address":        "10.0.1.10",
+					"openwire_endpoint": "ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-1.mq.us-west-2.amazonaws.com:61617", //lintignore:AWSAT003
+				},
+				map[string]interface{}{
+					"availability_zone": "us-west-2b",                                                                                //lintignore:AWSAT003
+					"console_url":       "https://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-2.mq.us-west-2.amazonaws.com:8162",          //lintignore:AWSAT003
+					"endpoints":         []string{"ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-2.mq.us-west-2.amazonaws.com:61617"}, //lintignore:AWSAT003
+					"ip_address":        "10.0.2.20",
+					"openwire_endpoint": "ssl://b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9-2.mq.us-west-2.amazonaws.com:61617", //lintignore:AWSAT003
+				},
+			},
+		},
+		"IP address outside subnets": {
+			instances: []types.BrokerInstance{
+				{
+					IpAddress: aws.String("192.168.0.10"),
+				},
+			},
+			azByCIDRBlock: azByCIDRBlock,
+			want: []interface{}{
+				map[string]interface{}{
+					"ip_address": "192.168.0.10",
+				},
+			},
+		},
+		"no subnets": {
+			instances: []types.BrokerInstance{
+				{
+					IpAddress: aws.String("10.0.1.10"),
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"ip_address": "10.0.1.10",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.FlattenBrokerInstances(testCase.instances, testCase.azByCIDRBlock)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenUsers(t *testing.T) {
+	t.Parallel()
+
+	cfgUsers := []interface{}{
+		map[string]interface{}{
+			"console_access":   true,
+			"groups":           schema.NewSet(schema.HashString, []interface{}{"admins"}),
+			"password":         "TestTest1234",
+			"replication_user": false,
+			"username":         "Test",
+		},
+	}
+
+	testCases := map[string]struct {
+		user *types.User
+		want map[string]interface{}
+	}{
+		"complete": {
+			user: &types.User{
+				ConsoleAccess:   aws.Bool(false),
+				Groups:          []string{"users"},
+				ReplicationUser: aws.Bool(true),
+				Username:        aws.String("Test"),
+			},
+			want: map[string]interface{}{
+				"console_access":   false,
+				"groups":           []string{"users"},
+				"password":         "TestTest1234",
+				"replication_user": true,
+				"username":         "Test",
+			},
+		},
+		"missing console access": {
+			user: &types.User{
+				Groups:          []string{"admins"},
+				ReplicationUser: aws.Bool(false),
+				Username:        aws.String("Test"),
+			},
+			want: map[string]interface{}{
+				"console_access":   true,
+				"groups":           []string{"admins"},
+				"password":         "TestTest1234",
+				"replication_user": false,
+				"username":         "Test",
+			},
+		},
+		"missing details": {
+			user: &types.User{
+				Username: aws.String("Test"),
+			},
+			want: map[string]interface{}{
+				"console_access":   true,
+				"groups":           []string{"admins"},
+				"password":         "TestTest1234",
+				"replication_user": false,
+				"username":         "Test",
+			},
+		},
+		"not configured": {
+			user: &types.User{
+				Username: aws.String("Other"),
+			},
+			want: map[string]interface{}{
+				"username": "Other",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfmq.FlattenUsers([]*types.User{testCase.user}, cfgUsers).List()
+
+			if len(got) != 1 {
+				t.Fatalf("got %d users, want 1", len(got))
+			}
+
+			if diff := cmp.Diff(got[0], testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandUsersForBroker(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const userCount = 50
+
+	var inFlight, maxInFlight atomic.Int32
+	conn := newMockClient(func(r *http.Request) (int, any) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		username := path.Base(r.URL.Path)
+
+		return http.StatusOK, map[string]any{
+			"brokerId":      "test",
+			"consoleAccess": strings.HasSuffix(username, "0"),
+			"groups":        []string{"group-" + username},
+			"username":      username,
+		}
+	})
+
+	input := make([]types.UserSummary, userCount)
+	for i := range input {
+		input[i] = types.UserSummary{Username: aws.String(fmt.Sprintf("user%02d", i))}
+	}
+
+	users, err := tfmq.ExpandUsersForBroker(ctx, conn, "test", input)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(users), userCount; got != want {
+		t.Fatalf("unexpected user count, got: %d, want: %d", got, want)
+	}
+
+	for i, u := range users {
+		username := fmt.Sprintf("user%02d", i)
+		want := &types.User{
+			ConsoleAccess: aws.Bool(strings.HasSuffix(username, "0")),
+			Groups:        []string{"group-" + username},
+			Username:      aws.String(username),
+		}
+
+		if diff := cmp.Diff(u, want, cmpopts.IgnoreUnexported(types.User{})); diff != "" {
+			t.Fatalf("unexpected user %d diff (+wanted, -got): %s", i, diff)
+		}
+	}
+
+	if got, limit := maxInFlight.Load(), int32(tfmq.DescribeUserConcurrency); got > limit || got < 2 {
+		t.Errorf("unexpected DescribeUser concurrency, got: %d, want: 2..%d", got, limit)
+	}
+}
+
+func TestExpandUsersForBroker_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	conn := newMockClient(func(r *http.Request) (int, any) {
+		if username := path.Base(r.URL.Path); username == "user03" {
+			return http.StatusNotFound, map[string]any{
+				"errorAttribute": "username",
+				"message":        "user03 not found",
+			}
+		}
+
+		return http.StatusOK, map[string]any{
+			"username": path.Base(r.URL.Path),
+		}
+	})
+
+	input := make([]types.UserSummary, 10)
+	for i := range input {
+		input[i] = types.UserSummary{Username: aws.String(fmt.Sprintf("user%02d", i))}
+	}
+
+	_, err := tfmq.ExpandUsersForBroker(ctx, conn, "test", input)
+
+	if !errs.IsA[*types.NotFoundException](err) {
+		t.Fatalf("expected NotFoundException, got: %v", err)
+	}
+}
+
+func TestFindBrokerUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		engineType types.EngineType
+		usernames  []string
+		wantOK     bool
+		wantUsers  []string
+	}{
+		"ActiveMQ": {
+			engineType: types.EngineTypeActivemq,
+			usernames:  []string{"Test1", "Test2"},
+			wantOK:     true,
+			wantUsers:  []string{"Test1", "Test2"},
+		},
+		"ActiveMQ no users": {
+			engineType: types.EngineTypeActivemq,
+			wantOK:     true,
+		},
+		"RabbitMQ users not returned": {
+			engineType: types.EngineTypeRabbitmq,
+		},
+		"RabbitMQ users returned": {
+			engineType: types.EngineTypeRabbitmq,
+			usernames:  []string{"Test1"},
+			wantOK:     true,
+			wantUsers:  []string{"Test1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				if path.Base(r.URL.Path) == "test" {
+					users := make([]map[string]any, len(testCase.usernames))
+					for i, username := range testCase.usernames {
+						users[i] = map[string]any{"username": username}
+					}
+
+					return http.StatusOK, map[string]any{
+						"brokerId":    "test",
+						"brokerState": string(types.BrokerStateRunning),
+						"engineType":  string(testCase.engineType),
+						"users":       users,
+					}
+				}
+
+				return http.StatusOK, map[string]any{
+					"brokerId": "test",
+					"username": path.Base(r.URL.Path),
+				}
+			})
+
+			output, err := tfmq.FindBrokerByID(ctx, conn, "test")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			users, ok, err := tfmq.FindBrokerUsers(ctx, conn, "test", output)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Fatalf("unexpected ok, got: %t, want: %t", got, want)
+			}
+
+			var got []string
+			for _, u := range users {
+				got = append(got, aws.ToString(u.Username))
+			}
+
+			if diff := cmp.Diff(got, testCase.wantUsers); diff != "" {
+				t.Errorf("unexpected users diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWaitBroker_criticalActionRequired(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]func(context.Context, *mq.Client, string, time.Duration) (*mq.DescribeBrokerOutput, error){
+		"created":  tfmq.WaitBrokerCreated,
+		"rebooted": tfmq.WaitBrokerRebooted,
+		"updated":  tfmq.WaitBrokerUpdated,
+	}
+
+	for name, waiter := range testCases {
+		name, waiter := name, waiter
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				return http.StatusOK, map[string]any{
+					"actionsRequired": []map[string]any{
+						{
+							"actionRequiredCode": "CRDR_INTERVENTION_REQUIRED",
+							"actionRequiredInfo": "Promote the replica broker",
+						},
+					},
+					"brokerId":    "test",
+					"brokerState": string(types.BrokerStateCriticalActionRequired),
+				}
+			})
+
+			_, err := waiter(ctx, conn, "test", 1*time.Minute)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := err.Error(), "requires manual action (CRDR_INTERVENTION_REQUIRED: Promote the replica broker)"; !strings.Contains(got, want) {
+				t.Errorf("unexpected error, got: %q, want to contain: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDeleteBroker(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		conflicts   int32
+		brokerState types.BrokerState
+		wantDeletes int32
+		wantErr     string
+	}{
+		"no conflict": {
+			brokerState: types.BrokerStateRunning,
+			wantDeletes: 1,
+		},
+		"conflict then success": {
+			conflicts:   1,
+			brokerState: types.BrokerStateRebootInProgress,
+			wantDeletes: 2,
+		},
+		"critical action required": {
+			conflicts:   1,
+			brokerState: types.BrokerStateCriticalActionRequired,
+			wantDeletes: 1,
+			wantErr:     "requires manual action (CRDR_INTERVENTION_REQUIRED: Promote the replica broker)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var deletes atomic.Int32
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				if r.Method == http.MethodDelete {
+					if deletes.Add(1) <= testCase.conflicts {
+						return http.StatusConflict, map[string]any{"message": "broker is busy"}
+					}
+
+					return http.StatusOK, map[string]any{"brokerId": "test"}
+				}
+
+				return http.StatusOK, map[string]any{
+					"actionsRequired": []map[string]any{
+						{
+							"actionRequiredCode": "CRDR_INTERVENTION_REQUIRED",
+							"actionRequiredInfo": "Promote the replica broker",
+						},
+					},
+					"brokerId":    "test",
+					"brokerState": string(testCase.brokerState),
+				}
+			})
+
+			err := tfmq.DeleteBroker(ctx, conn, "test", 1*time.Minute)
+
+			if testCase.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got, want := err.Error(), testCase.wantErr; !strings.Contains(got, want) {
+					t.Errorf("unexpected error, got: %q, want to contain: %q", got, want)
+				}
+			}
+
+			if got, want := deletes.Load(), testCase.wantDeletes; got != want {
+				t.Errorf("unexpected DeleteBroker calls, got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateBroker(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	state := &terraformsdk.InstanceState{
+		ID: "b-1234a5b6-78cd-901e-2fgh-3i45j6k178l9",
+		Attributes: map[string]string{
+			"auto_minor_version_upgrade": "false",
+			"engine_type":                "ActiveMQ",
+			"engine_version":             "5.17.6",
+			"host_instance_type":         "mq.t3.micro",
+			"security_groups.#":          "1",
+			"security_groups.1":          "sg-1234",
+		},
+	}
+
+	testCases := map[string]struct {
+		diff              map[string]*terraformsdk.ResourceAttrDiff
+		wantBody          map[string]any
+		wantUpdates       int32
+		wantRequireReboot bool
+	}{
+		"no changes": {},
+		"security groups only": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"security_groups.1": {Old: "sg-1234", New: "", NewRemoved: true},
+				"security_groups.2": {Old: "", New: "sg-5678"},
+			},
+			wantBody: map[string]any{
+				"securityGroups": []any{"sg-5678"},
+			},
+			wantUpdates: 1,
+		},
+		"multiple attributes": {
+			diff: map[string]*terraformsdk.ResourceAttrDiff{
+				"auto_minor_version_upgrade": {Old: "false", New: "true"},
+				"engine_version":             {Old: "5.17.6", New: "5.18.4"},
+				"host_instance_type":         {Old: "mq.t3.micro", New: "mq.m5.large"},
+				"security_groups.1":          {Old: "sg-1234", New: "", NewRemoved: true},
+				"security_groups.2":          {Old: "", New: "sg-5678"},
+			},
+			wantBody: map[string]any{
+				"autoMinorVersionUpgrade": true,
+				"engineVersion":           "5.18.4",
+				"hostInstanceType":        "mq.m5.large",
+				"securityGroups":          []any{"sg-5678"},
+			},
+			wantUpdates:       1,
+			wantRequireReboot: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(state, &terraformsdk.InstanceDiff{Attributes: testCase.diff})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var updates atomic.Int32
+			var body map[string]any
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				if r.Method == http.MethodPut {
+					updates.Add(1)
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						return http.StatusBadRequest, map[string]any{"message": err.Error()}
+					}
+				}
+
+				return http.StatusOK, map[string]any{"brokerId": state.ID}
+			})
+
+			requiresReboot, err := tfmq.UpdateBroker(ctx, conn, d)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := updates.Load(), testCase.wantUpdates; got != want {
+				t.Errorf("UpdateBroker calls = %d, want %d", got, want)
+			}
+			if got, want := requiresReboot, testCase.wantRequireReboot; got != want {
+				t.Errorf("requiresReboot = %t, want %t", got, want)
+			}
+			for k, want := range testCase.wantBody {
+				if diff := cmp.Diff(body[k], want); diff != "" {
+					t.Errorf("unexpected %s diff (+wanted, -got): %s", k, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateBrokerConfiguration(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	state := &terraformsdk.InstanceState{
+		Attributes: map[string]string{
+			"broker_name":    "test",
+			"engine_type":    "ActiveMQ",
+			"engine_version": "5.17.6",
+		},
+	}
+
+	testCases := map[string]struct {
+		configurations []map[string]any
+		wantCreates    int32
+		wantID         string
+	}{
+		"no configurations": {
+			wantCreates: 1,
+			wantID:      "c-created",
+		},
+		"retained configuration": {
+			configurations: []map[string]any{
+				{
+					"engineType":     "ActiveMQ",
+					"engineVersion":  "5.17.6",
+					"id":             "c-retained",
+					"latestRevision": map[string]any{"description": "Managed by Terraform for MQ Broker configuration_document", "revision": 1},
+					"name":           "test",
+				},
+			},
+			wantID: "c-retained",
+		},
+		"configuration not created for configuration_document": {
+			configurations: []map[string]any{
+				{
+					"engineType":     "ActiveMQ",
+					"engineVersion":  "5.17.6",
+					"id":             "c-other",
+					"latestRevision": map[string]any{"description": "Auto-generated default for test", "revision": 1},
+					"name":           "test",
+				},
+			},
+			wantCreates: 1,
+			wantID:      "c-created",
+		},
+		"retained configuration for another engine version": {
+			configurations: []map[string]any{
+				{
+					"engineType":     "ActiveMQ",
+					"engineVersion":  "5.18.4",
+					"id":             "c-retained",
+					"latestRevision": map[string]any{"description": "Managed by Terraform for MQ Broker configuration_document", "revision": 1},
+					"name":           "test",
+				},
+			},
+			wantCreates: 1,
+			wantID:      "c-created",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var creates atomic.Int32
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				switch r.Method {
+				case http.MethodGet:
+					return http.StatusOK, map[string]any{"configurations": testCase.configurations}
+				case http.MethodPost:
+					creates.Add(1)
+
+					return http.StatusOK, map[string]any{"id": "c-created"}
+				default:
+					return http.StatusOK, map[string]any{
+						"id":             path.Base(r.URL.Path),
+						"latestRevision": map[string]any{"revision": 2},
+					}
+				}
+			})
+
+			d, err := schema.InternalMap(tfmq.ResourceBroker().SchemaMap()).Data(state, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			output, err := tfmq.CreateBrokerConfiguration(ctx, conn, d, "<broker/>")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := creates.Load(), testCase.wantCreates; got != want {
+				t.Errorf("unexpected CreateConfiguration calls, got: %d, want: %d", got, want)
+			}
+
+			if got, want := aws.ToString(output.Id), testCase.wantID; got != want {
+				t.Errorf("unexpected configuration ID, got: %q, want: %q", got, want)
+			}
+
+			if got, want := aws.ToInt32(output.Revision), int32(2); got != want {
+				t.Errorf("unexpected configuration revision, got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestBrokerUpdatePending(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		broker map[string]any
+		want   bool
+	}{
+		"no pending changes": {
+			broker: map[string]any{
+				"engineVersion":    "5.17.6",
+				"hostInstanceType": "mq.t3.micro",
+				"securityGroups":   []string{"sg-1", "sg-2"},
+			},
+		},
+		"pending values match active values": {
+			broker: map[string]any{
+				"configurations": map[string]any{
+					"current": map[string]any{"id": "c-1234", "revision": 2},
+					"pending": map[string]any{"id": "c-1234", "revision": 2},
+				},
+				"engineVersion":           "5.17.6",
+				"hostInstanceType":        "mq.t3.micro",
+				"pendingEngineVersion":    "5.17.6",
+				"pendingHostInstanceType": "mq.t3.micro",
+				"pendingSecurityGroups":   []string{"sg-2", "sg-1"},
+				"securityGroups":          []string{"sg-1", "sg-2"},
+			},
+		},
+		"multiple pending changes": {
+			broker: map[string]any{
+				"configurations": map[string]any{
+					"current": map[string]any{"id": "c-1234", "revision": 2},
+					"pending": map[string]any{"id": "c-1234", "revision": 3},
+				},
+				"engineVersion":           "5.17.6",
+				"hostInstanceType":        "mq.t3.micro",
+				"pendingEngineVersion":    "5.18.4",
+				"pendingHostInstanceType": "mq.m5.large",
+				"pendingSecurityGroups":   []string{"sg-1", "sg-3"},
+				"securityGroups":          []string{"sg-1", "sg-2"},
+			},
+			want: true,
+		},
+		"pending security groups": {
+			broker: map[string]any{
+				"pendingSecurityGroups": []string{"sg-1"},
+				"securityGroups":        []string{"sg-1", "sg-2"},
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				broker := map[string]any{
+					"brokerId":    "test",
+					"brokerState": string(types.BrokerStateRunning),
+				}
+				for k, v := range testCase.broker {
+					broker[k] = v
+				}
+
+				return http.StatusOK, broker
+			})
+
+			output, err := tfmq.FindBrokerByID(ctx, conn, "test")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := tfmq.BrokerUpdatePending(output), testCase.want; got != want {
+				t.Errorf("unexpected update pending, got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigurationDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		configured   []interface{}
+		current      int
+		pending      int
+		wantDrift    bool
+		wantRevision int32
+	}{
+		"no configuration": {
+			current: 3,
+		},
+		"revision not configured": {
+			configured: []interface{}{map[string]interface{}{"id": "c-1234", "revision": 0}},
+			current:    3,
+		},
+		"in sync": {
+			configured: []interface{}{map[string]interface{}{"id": "c-1234", "revision": 3}},
+			current:    3,
+		},
+		"pending reboot": {
+			configured: []interface{}{map[string]interface{}{"id": "c-1234", "revision": 3}},
+			current:    2,
+			pending:    3,
+		},
+		"drifted": {
+			configured:   []interface{}{map[string]interface{}{"id": "c-1234", "revision": 2}},
+			current:      3,
+			wantDrift:    true,
+			wantRevision: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockClient(func(r *http.Request) (int, any) {
+				configurations := map[string]any{
+					"current": map[string]any{"id": "c-1234", "revision": testCase.current},
+				}
+				if testCase.pending > 0 {
+					configurations["pending"] = map[string]any{"id": "c-1234", "revision": testCase.pending}
+				}
+
+				return http.StatusOK, map[string]any{
+					"brokerId":       "test",
+					"brokerState":    string(types.BrokerStateRunning),
+					"configurations": configurations,
+				}
+			})
+
+			output, err := tfmq.FindBrokerByID(ctx, conn, "test")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			revision, drift := tfmq.ConfigurationDrift(testCase.configured, output.Configurations)
+
+			if got, want := drift, testCase.wantDrift; got != want {
+				t.Errorf("unexpected drift, got: %t, want: %t", got, want)
+			}
+
+			if drift {
+				if got, want := revision, testCase.wantRevision; got != want {
+					t.Errorf("unexpected revision, got: %d, want: %d", got, want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkExpandUsersForBroker(b *testing.B) {
+	ctx := context.Background()
+
+	conn := newMockClient(func(r *http.Request) (int, any) {
+		time.Sleep(5 * time.Millisecond)
+
+		return http.StatusOK, map[string]any{
+			"username": path.Base(r.URL.Path),
+		}
+	})
+
+	input := make([]types.UserSummary, 50)
+	for i := range input {
+		input[i] = types.UserSummary{Username: aws.String(fmt.Sprintf("user%02d", i))}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tfmq.ExpandUsersForBroker(ctx, conn, "test", input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// testBrokerRawConfig returns a raw configuration value for the broker resource with the specified attributes set and all others null.
+func testBrokerRawConfig(r *schema.Resource, attrs map[string]cty.Value) cty.Value {
+	vals := make(map[string]cty.Value)
+	for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		vals[name] = cty.NullVal(ty)
+	}
+	maps.Copy(vals, attrs)
+
+	return cty.ObjectVal(vals)
+}
+
+// newMockClient returns an MQ client whose requests are answered by the
+// specified handler, which returns an HTTP status code and a JSON-serializable body.
+func newMockClient(handler func(*http.Request) (int, any)) *mq.Client {
+	return mq.New(mq.Options{
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: mockHTTPClientFunc(func(r *http.Request) (*http.Response, error) {
+			status, body := handler(r)
+
+			b, err := json.Marshal(body)
+			if err != nil {
+				return nil, err
+			}
+
+			header := http.Header{}
+			header.Set("Content-Type", "application/json")
+			if status >= http.StatusBadRequest {
+				header.Set("X-Amzn-Errortype", map[int]string{
+					http.StatusBadRequest: "BadRequestException",
+					http.StatusForbidden:  "ForbiddenException",
+					http.StatusNotFound:   "NotFoundException",
+					http.StatusConflict:   "ConflictException",
+				}[status])
+			}
+
+			return &http.Response{
+				StatusCode: status,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewReader(b)),
+				Request:    r,
+			}, nil
+		}),
+		Region:           names.USWest2RegionID,
+		RetryMaxAttempts: 1,
+	})
+}
+
+type mockHTTPClientFunc func(*http.Request) (*http.Response, error)
+
+func (f mockHTTPClientFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+const (
+	testAccBrokerVersionNewer = "5.17.6"  // before changing, check b/c must be valid on GovCloud
+	testAccBrokerVersionOlder = "5.16.7"  // before changing, check b/c must be valid on GovCloud
+	testAccRabbitVersion      = "3.11.20" // before changing, check b/c must be valid on GovCloud
+)
+
+func TestAccMQBroker_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "broker_state", "RUNNING"),
+					resource.TestCheckNoResourceAttr(resourceName, "cluster_member_count"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.revision", regexache.MustCompile(`^[0-9]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "SINGLE_INSTANCE"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "instances.0.availability_zone"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.day_of_week"),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.time_of_day"),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "Test",
+						"password":       "TestTest1234",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmq.ResourceBroker(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerRetained(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_skipDestroy(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_creatorRequestID(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+	creatorRequestID := sdkacctest.RandString(36)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_creatorRequestID(rName, testAccBrokerVersionNewer, creatorRequestID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "creator_request_id", creatorRequestID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "creator_request_id", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_tags1(rName, testAccBrokerVersionNewer, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			{
+				Config: testAccBrokerConfig_tags2(rName, testAccBrokerVersionNewer, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBrokerConfig_tags1(rName, testAccBrokerVersionNewer, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// Broker tags are not propagated to the configurations that the broker references, and vice versa.
+func TestAccMQBroker_Tags_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+	configurationResourceName := "aws_mq_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_tagsConfiguration(rName, testAccBrokerVersionNewer, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					testAccCheckConfigurationExists(ctx, configurationResourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.brokerKey", "value1"),
+					resource.TestCheckResourceAttr(configurationResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(configurationResourceName, "tags.configurationKey", "configurationValue"),
+				),
+			},
+			{
+				Config: testAccBrokerConfig_tagsConfiguration(rName, testAccBrokerVersionNewer, "value2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(configurationResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.brokerKey", "value2"),
+					resource.TestCheckResourceAttr(configurationResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(configurationResourceName, "tags.configurationKey", "configurationValue"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_throughputOptimized(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_ebs(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.revision", regexache.MustCompile(`^[0-9]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "SINGLE_INSTANCE"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "ebs"),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.day_of_week"),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.time_of_day"),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "Test",
+						"password":       "TestTest1234",
+					}),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_AllFields_defaultVPC(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	resourceName := "aws_mq_broker.test"
+
+	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>`
+	cfgBodyAfter := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+  <plugins>
+    <forcePersistencyModeBrokerPlugin persistenceFlag="true"/>
+    <statisticsBrokerPlugin/>
+    <timeStampingBrokerPlugin ttlCeiling="86400000" zeroExpirationOverride="86400000"/>
+  </plugins>
+</broker>`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyBefore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "ACTIVE_STANDBY_MULTI_AZ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "CET"),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "true",
+						"groups.#":       "3",
+						"username":       "SecondTest",
+						"password":       "SecondTestTest1234",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "first"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "second"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "third"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "Test",
+						"password":       "TestTest1234",
+					}),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.1.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			{
+				// Update configuration in-place
+				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "3"),
+				),
+			},
+			{
+				// Replace configuration
+				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rNameUpdated, cfgBodyAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	resourceName := "aws_mq_broker.test"
+
+	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>`
+	cfgBodyAfter := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+  <plugins>
+    <forcePersistencyModeBrokerPlugin persistenceFlag="true"/>
+    <statisticsBrokerPlugin/>
+    <timeStampingBrokerPlugin ttlCeiling="86400000" zeroExpirationOverride="86400000"/>
+  </plugins>
+</broker>`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyBefore, "CET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "ACTIVE_STANDBY_MULTI_AZ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "CET"),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "true"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "true",
+						"groups.#":       "3",
+						"username":       "SecondTest",
+						"password":       "SecondTestTest1234",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "first"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "second"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "third"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "Test",
+						"password":       "TestTest1234",
+					}),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.console_url",
+						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.ip_address",
+						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.1.endpoints.#", "5"),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
+					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			{
+				// Update configuration in-place
+				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyAfter, "GMT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "3"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "GMT"),
+				),
+			},
+			{
+				// Replace configuration
+				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rNameUpdated, cfgBodyAfter, "GMT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	kmsKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_encryptionOptionsKMSKeyID(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_options.0.kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_EncryptionOptions_managedKeyDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
 	}
 
 	var broker mq.DescribeBrokerOutput
@@ -431,57 +3597,62 @@ func TestAccMQBroker_throughputOptimized(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_ebs(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_EncryptionOptions_managedKeyEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.revision", regexache.MustCompile(`^[0-9]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "SINGLE_INSTANCE"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
-					resource.TestCheckResourceAttr(resourceName, "storage_type", "ebs"),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.m5.large"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
-					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.day_of_week"),
-					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window_start_time.0.time_of_day"),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "UTC"),
-					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "Test",
-						"password":       "TestTest1234",
-					}),
-					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
 		},
 	})
 }
 
-func TestAccMQBroker_AllFields_defaultVPC(t *testing.T) {
+func TestAccMQBroker_EncryptionOptions_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -489,20 +3660,64 @@ func TestAccMQBroker_AllFields_defaultVPC(t *testing.T) {
 
 	var broker mq.DescribeBrokerOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
 	resourceName := "aws_mq_broker.test"
 
-	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
-<broker xmlns="http://activemq.apache.org/schema/core">
-</broker>`
-	cfgBodyAfter := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
-<broker xmlns="http://activemq.apache.org/schema/core">
-  <plugins>
-    <forcePersistencyModeBrokerPlugin persistenceFlag="true"/>
-    <statisticsBrokerPlugin/>
-    <timeStampingBrokerPlugin ttlCeiling="86400000" zeroExpirationOverride="86400000"/>
-  </plugins>
-</broker>`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
+				),
+			},
+			// AWS-owned key -> AWS-managed key recreates the broker.
+			{
+				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "false"),
+				),
+			},
+			// AWS-managed key -> customer managed key recreates the broker.
+			{
+				Config: testAccBrokerConfig_encryptionOptionsKMSKeyID(rName, testAccBrokerVersionNewer),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_options.0.kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_Update_users(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -515,102 +3730,122 @@ func TestAccMQBroker_AllFields_defaultVPC(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyBefore),
+				Config: testAccBrokerConfig_updateUsers1(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "first",
+						"password":       "TestTest1111",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			// Adding new user + modify existing
+			{
+				Config: testAccBrokerConfig_updateUsers2(rName, testAccBrokerVersionNewer),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
-					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "ACTIVE_STANDBY_MULTI_AZ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
-					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "CET"),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
-					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"groups.#":       "0",
+						"username":       "second",
+						"password":       "TestTest2222",
+					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
 						"console_access": "true",
-						"groups.#":       "3",
-						"username":       "SecondTest",
-						"password":       "SecondTestTest1234",
+						"groups.#":       "0",
+						"username":       "first",
+						"password":       "TestTest1111updated",
 					}),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "first"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "second"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "third"),
+				),
+			},
+			// Deleting user + modify existing
+			{
+				Config: testAccBrokerConfig_updateUsers3(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
 						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "Test",
-						"password":       "TestTest1234",
+						"groups.#":       "1",
+						"username":       "second",
+						"password":       "TestTest2222",
 					}),
-					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.1.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_Update_securityGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 			{
-				// Update configuration in-place
-				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyAfter),
+				Config: testAccBrokerConfig_updateSecurityGroups(rName, testAccBrokerVersionNewer),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "3"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
 				),
 			},
+			// Trigger a reboot and ensure the password change was applied
+			// User hashcode can be retrieved by calling resourceUserHash
 			{
-				// Replace configuration
-				Config: testAccBrokerConfig_allFieldsDefaultVPC(rName, testAccBrokerVersionNewer, rNameUpdated, cfgBodyAfter),
+				Config: testAccBrokerConfig_updateUsersSecurityGroups(rName, testAccBrokerVersionNewer),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"username": "Test",
+						"password": "TestTest9999",
+					}),
 				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
+func TestAccMQBroker_Update_engineVersion(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -618,7 +3853,50 @@ func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
 
 	var broker mq.DescribeBrokerOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionOlder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionOlder),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			{
+				Config: testAccBrokerConfig_engineVersionUpdate(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_configurationDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker1, broker2 mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
@@ -629,7 +3907,6 @@ func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
   <plugins>
     <forcePersistencyModeBrokerPlugin persistenceFlag="true"/>
     <statisticsBrokerPlugin/>
-    <timeStampingBrokerPlugin ttlCeiling="86400000" zeroExpirationOverride="86400000"/>
   </plugins>
 </broker>`
 
@@ -644,114 +3921,45 @@ func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyBefore, "CET"),
+				Config: testAccBrokerConfig_configurationDocument(rName, testAccBrokerVersionNewer, cfgBodyBefore),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					testAccCheckBrokerExists(ctx, resourceName, &broker1),
 					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.id"),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
-					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "ACTIVE_STANDBY_MULTI_AZ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
-					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "CET"),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "true"),
-					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "true"),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "true",
-						"groups.#":       "3",
-						"username":       "SecondTest",
-						"password":       "SecondTestTest1234",
-					}),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "first"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "second"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "third"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "Test",
-						"password":       "TestTest1234",
-					}),
-					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexache.MustCompile(`broker:+.`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.console_url",
-						regexache.MustCompile(`^https://[0-9a-f-]+\.mq.[0-9a-z-]+.amazonaws.com:8162$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.ip_address",
-						regexache.MustCompile(`^\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}$`)),
-					resource.TestCheckResourceAttr(resourceName, "instances.1.endpoints.#", "5"),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.0", regexache.MustCompile(`^ssl://[0-9a-z.-]+:61617$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.1", regexache.MustCompile(`^amqp\+ssl://[0-9a-z.-]+:5671$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.2", regexache.MustCompile(`^stomp\+ssl://[0-9a-z.-]+:61614$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.3", regexache.MustCompile(`^mqtt\+ssl://[0-9a-z.-]+:8883$`)),
-					resource.TestMatchResourceAttr(resourceName, "instances.1.endpoints.4", regexache.MustCompile(`^wss://[0-9a-z.-]+:61619$`)),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				ImportStateVerifyIgnore: []string{"apply_immediately", "configuration_document", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 			{
-				// Update configuration in-place
-				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rName, cfgBodyAfter, "GMT"),
+				Config: testAccBrokerConfig_configurationDocument(rName, testAccBrokerVersionNewer, cfgBodyAfter),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					testAccCheckBrokerExists(ctx, resourceName, &broker2),
+					testAccCheckBrokerNotRecreated(&broker1, &broker2),
 					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "3"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_of_day", "02:00"),
-					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.time_zone", "GMT"),
 				),
 			},
 			{
-				// Replace configuration
-				Config: testAccBrokerConfig_allFieldsCustomVPC(rName, testAccBrokerVersionNewer, rNameUpdated, cfgBodyAfter, "GMT"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
-					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
-				),
+				Config:      testAccBrokerConfig_configurationDocumentConflict(rName, testAccBrokerVersionNewer, cfgBodyAfter),
+				ExpectError: regexache.MustCompile(`"configuration_document" cannot be specified with "configuration"`),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
+func TestAccMQBroker_Update_hostInstanceType(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var broker mq.DescribeBrokerOutput
+	var broker1, broker2 mq.DescribeBrokerOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	kmsKeyResourceName := "aws_kms_key.test"
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -765,31 +3973,31 @@ func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_encryptionOptionsKMSKeyID(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_instanceType(rName, testAccBrokerVersionNewer, "mq.t2.micro"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
-					resource.TestCheckResourceAttrPair(resourceName, "encryption_options.0.kms_key_id", kmsKeyResourceName, "arn"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "false"),
+					testAccCheckBrokerExists(ctx, resourceName, &broker1),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				Config: testAccBrokerConfig_instanceType(rName, testAccBrokerVersionNewer, "mq.t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker2),
+					testAccCheckBrokerNotRecreated(&broker1, &broker2),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_EncryptionOptions_managedKeyDisabled(t *testing.T) {
+func TestAccMQBroker_Update_waitForSteadyState(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var broker mq.DescribeBrokerOutput
+	var broker1, broker2 mq.DescribeBrokerOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
@@ -804,24 +4012,27 @@ func TestAccMQBroker_EncryptionOptions_managedKeyDisabled(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, false),
+				Config: testAccBrokerConfig_waitForSteadyState(rName, testAccBrokerVersionNewer, "MONDAY"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "false"),
+					testAccCheckBrokerExists(ctx, resourceName, &broker1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "MONDAY"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				Config: testAccBrokerConfig_waitForSteadyState(rName, testAccBrokerVersionNewer, "TUESDAY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker2),
+					testAccCheckBrokerNotRecreated(&broker1, &broker2),
+					resource.TestCheckResourceAttr(resourceName, "broker_state", string(types.BrokerStateRunning)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window_start_time.0.day_of_week", "TUESDAY"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_EncryptionOptions_managedKeyEnabled(t *testing.T) {
+func TestAccMQBroker_Update_storageType(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -842,24 +4053,29 @@ func TestAccMQBroker_EncryptionOptions_managedKeyEnabled(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_encryptionOptionsManagedKey(rName, testAccBrokerVersionNewer, true),
+				Config: testAccBrokerConfig_storageType(rName, testAccBrokerVersionNewer, "efs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "efs"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				Config: testAccBrokerConfig_storageType(rName, testAccBrokerVersionNewer, "ebs"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "ebs"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_Update_users(t *testing.T) {
+func TestAccMQBroker_Update_auditLog(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -880,64 +4096,34 @@ func TestAccMQBroker_Update_users(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_updateUsers1(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_auditLog(rName, testAccBrokerVersionNewer, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "first",
-						"password":       "TestTest1111",
-					}),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "true"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
-			},
-			// Adding new user + modify existing
-			{
-				Config: testAccBrokerConfig_updateUsers2(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_auditLog(rName, testAccBrokerVersionNewer, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "2"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "0",
-						"username":       "second",
-						"password":       "TestTest2222",
-					}),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "true",
-						"groups.#":       "0",
-						"username":       "first",
-						"password":       "TestTest1111updated",
-					}),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "false"),
 				),
 			},
-			// Deleting user + modify existing
-			{
-				Config: testAccBrokerConfig_updateUsers3(rName, testAccBrokerVersionNewer),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"console_access": "false",
-						"groups.#":       "1",
-						"username":       "second",
-						"password":       "TestTest2222",
-					}),
-					resource.TestCheckTypeSetElemAttr(resourceName, "user.*.groups.*", "admin"),
+			{
+				Config: testAccBrokerConfig_auditLog(rName, testAccBrokerVersionNewer, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", "true"),
 				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_Update_securityGroup(t *testing.T) {
+func TestAccMQBroker_Update_authenticationStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -958,44 +4144,30 @@ func TestAccMQBroker_Update_securityGroup(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				Config:      testAccBrokerConfig_authenticationStrategyLDAPNoMetadata(rName, testAccBrokerVersionNewer),
+				ExpectError: regexache.MustCompile(`ldap_server_metadata: Must be configured when authentication_strategy is LDAP`),
 			},
 			{
-				Config: testAccBrokerConfig_updateSecurityGroups(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_authenticationStrategy(rName, testAccBrokerVersionNewer, "ldap"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "ldap"),
+					resource.TestCheckResourceAttr(resourceName, "pending_authentication_strategy", ""),
 				),
 			},
-			// Trigger a reboot and ensure the password change was applied
-			// User hashcode can be retrieved by calling resourceUserHash
 			{
-				Config: testAccBrokerConfig_updateUsersSecurityGroups(rName, testAccBrokerVersionNewer),
+				Config: testAccBrokerConfig_authenticationStrategy(rName, testAccBrokerVersionNewer, "simple"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
-						"username": "Test",
-						"password": "TestTest9999",
-					}),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
+					resource.TestCheckResourceAttr(resourceName, "pending_authentication_strategy", ""),
 				),
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_Update_engineVersion(t *testing.T) {
+func TestAccMQBroker_RabbitMQ_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1016,36 +4188,38 @@ func TestAccMQBroker_Update_engineVersion(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_basic(rName, testAccBrokerVersionOlder),
+				Config: testAccBrokerConfig_rabbit(rName, testAccRabbitVersion),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionOlder),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccRabbitVersion),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^amqps://[0-9a-z.-]+:5671$`)),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", ""),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
-			},
-			{
-				Config: testAccBrokerConfig_engineVersionUpdate(rName, testAccBrokerVersionNewer),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
-				),
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_Update_hostInstanceType(t *testing.T) {
+func TestAccMQBroker_RabbitMQ_config(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var broker1, broker2 mq.DescribeBrokerOutput
+	var broker mq.DescribeBrokerOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
@@ -1060,25 +4234,34 @@ func TestAccMQBroker_Update_hostInstanceType(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_instanceType(rName, testAccBrokerVersionNewer, "mq.t2.micro"),
+				Config: testAccBrokerConfig_rabbitConfig(rName, testAccRabbitVersion),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker1),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t2.micro"),
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccRabbitVersion),
+					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^amqps://[0-9a-z.-]+:5671$`)),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", ""),
 				),
 			},
 			{
-				Config: testAccBrokerConfig_instanceType(rName, testAccBrokerVersionNewer, "mq.t3.micro"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(ctx, resourceName, &broker2),
-					testAccCheckBrokerNotRecreated(&broker1, &broker2),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
-				),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_RabbitMQ_basic(t *testing.T) {
+func TestAccMQBroker_RabbitMQ_configLatestRevision(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1099,32 +4282,29 @@ func TestAccMQBroker_RabbitMQ_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_rabbit(rName, testAccRabbitVersion),
+				Config: testAccBrokerConfig_rabbitConfigLatestRevision(rName, testAccRabbitVersion),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
 					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccRabbitVersion),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^amqps://[0-9a-z.-]+:5671$`)),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", ""),
+					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
+			},
+			{
+				Config:   testAccBrokerConfig_rabbitConfigLatestRevision(rName, testAccRabbitVersion),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccMQBroker_RabbitMQ_config(t *testing.T) {
+func TestAccMQBroker_RabbitMQ_Update_configurationID(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1145,28 +4325,26 @@ func TestAccMQBroker_RabbitMQ_config(t *testing.T) {
 		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBrokerConfig_rabbitConfig(rName, testAccRabbitVersion),
+				Config: testAccBrokerConfig_rabbitConfigID(rName, testAccRabbitVersion, "test1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
 					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "configuration.0.id", regexache.MustCompile(`^c-[0-9a-z-]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.revision", "2"),
-					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
-					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccRabbitVersion),
-					resource.TestCheckResourceAttr(resourceName, "host_instance_type", "mq.t3.micro"),
-					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "1"),
-					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexache.MustCompile(`^amqps://[0-9a-z.-]+:5671$`)),
-					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "false"),
-					resource.TestCheckResourceAttr(resourceName, "logs.0.audit", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.id", "aws_mq_configuration.test1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.revision", "aws_mq_configuration.test1", "latest_revision"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				Config: testAccBrokerConfig_rabbitConfigID(rName, testAccRabbitVersion, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.id", "aws_mq_configuration.test2", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.revision", "aws_mq_configuration.test2", "latest_revision"),
+				),
+			},
+			{
+				Config:   testAccBrokerConfig_rabbitConfigID(rName, testAccRabbitVersion, "test2"),
+				PlanOnly: true,
 			},
 		},
 	})
@@ -1212,7 +4390,7 @@ func TestAccMQBroker_RabbitMQ_logs(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1283,6 +4461,8 @@ func TestAccMQBroker_RabbitMQ_cluster(t *testing.T) {
 					testAccCheckBrokerExists(ctx, resourceName, &broker),
 					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
 					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "broker_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_member_count", "3"),
 					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "CLUSTER_MULTI_AZ"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_options.0.use_aws_owned_key", "true"),
@@ -1318,7 +4498,7 @@ func TestAccMQBroker_RabbitMQ_cluster(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "skip_destroy", "user", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1365,6 +4545,12 @@ func TestAccMQBroker_ldap(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.user_search_subtree", "true"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "detect_configuration_drift", "ldap_server_metadata.0.service_account_password", "skip_destroy", "user", "wait_for_steady_state"},
+			},
 		},
 	})
 }
@@ -1395,6 +4581,34 @@ func testAccCheckBrokerDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckBrokerRetained verifies that brokers configured with skip_destroy survive destroy,
+// then deletes them so that the test does not leave dangling brokers.
+func testAccCheckBrokerRetained(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MQClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mq_broker" {
+				continue
+			}
+
+			if _, err := tfmq.FindBrokerByID(ctx, conn, rs.Primary.ID); err != nil {
+				return fmt.Errorf("MQ Broker %s not retained: %w", rs.Primary.ID, err)
+			}
+
+			_, err := conn.DeleteBroker(ctx, &mq.DeleteBrokerInput{
+				BrokerId: aws.String(rs.Primary.ID),
+			})
+
+			if err != nil {
+				return fmt.Errorf("deleting retained MQ Broker %s: %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBrokerExists(ctx context.Context, n string, v *mq.DescribeBrokerOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1473,6 +4687,108 @@ resource "aws_mq_broker" "test" {
 `, rName, version)
 }
 
+func testAccBrokerConfig_skipDestroy(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  authentication_strategy = "simple"
+  storage_type            = "efs"
+  skip_destroy            = true
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
+func testAccBrokerConfig_creatorRequestID(rName, version, creatorRequestID string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  authentication_strategy = "simple"
+  storage_type            = "efs"
+  creator_request_id      = %[3]q
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, creatorRequestID)
+}
+
+func testAccBrokerConfig_configurationDocument(rName, version, cfgBody string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  configuration_document = <<DATA
+%[3]s
+DATA
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, cfgBody)
+}
+
+func testAccBrokerConfig_configurationDocumentConflict(rName, version, cfgBody string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  configuration {
+    id = "c-12345678-1234-1234-1234-123456789012"
+  }
+
+  configuration_document = <<DATA
+%[3]s
+DATA
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, cfgBody)
+}
+
 func testAccBrokerConfig_ebs(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -1852,10 +5168,40 @@ resource "aws_mq_broker" "test" {
     groups   = ["admin"]
   }
 }
-`, rName, version)
+`, rName, version)
+}
+
+func testAccBrokerConfig_tags1(rName, version, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, version, tagKey1, tagValue1)
 }
 
-func testAccBrokerConfig_tags1(rName, version, tagKey1, tagValue1 string) string {
+func testAccBrokerConfig_tagsConfiguration(rName, version, brokerTagValue string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
   name = %[1]q
@@ -1865,6 +5211,22 @@ resource "aws_security_group" "test" {
   }
 }
 
+resource "aws_mq_configuration" "test" {
+  name           = %[1]q
+  engine_type    = "ActiveMQ"
+  engine_version = %[2]q
+
+  data = <<DATA
+<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>
+DATA
+
+  tags = {
+    configurationKey = "configurationValue"
+  }
+}
+
 resource "aws_mq_broker" "test" {
   apply_immediately  = true
   broker_name        = %[1]q
@@ -1873,16 +5235,21 @@ resource "aws_mq_broker" "test" {
   host_instance_type = "mq.t2.micro"
   security_groups    = [aws_security_group.test.id]
 
+  configuration {
+    id       = aws_mq_configuration.test.id
+    revision = aws_mq_configuration.test.latest_revision
+  }
+
   user {
     username = "Test"
     password = "TestTest1234"
   }
 
   tags = {
-    %[3]q = %[4]q
+    brokerKey = %[3]q
   }
 }
-`, rName, version, tagKey1, tagValue1)
+`, rName, version, brokerTagValue)
 }
 
 func testAccBrokerConfig_tags2(rName, version, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
@@ -2060,6 +5427,104 @@ resource "aws_mq_broker" "test" {
 `, rName, version)
 }
 
+func testAccBrokerConfig_rabbitConfigLatestRevision(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_configuration" "test" {
+  description    = "TfAccTest MQ Configuration"
+  name           = %[1]q
+  engine_type    = "RabbitMQ"
+  engine_version = %[2]q
+
+  data = <<DATA
+  # Default RabbitMQ delivery acknowledgement timeout is 30 minutes
+  consumer_timeout = 1800000
+  
+  DATA
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  engine_type        = "RabbitMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t3.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  configuration {
+    id = aws_mq_configuration.test.id
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
+func testAccBrokerConfig_rabbitConfigID(rName, version, configurationName string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_configuration" "test1" {
+  description    = "TfAccTest MQ Configuration"
+  name           = "%[1]s-1"
+  engine_type    = "RabbitMQ"
+  engine_version = %[2]q
+
+  data = <<DATA
+  # Default RabbitMQ delivery acknowledgement timeout is 30 minutes
+  consumer_timeout = 1800000
+  
+  DATA
+}
+
+resource "aws_mq_configuration" "test2" {
+  description    = "TfAccTest MQ Configuration"
+  name           = "%[1]s-2"
+  engine_type    = "RabbitMQ"
+  engine_version = %[2]q
+
+  data = <<DATA
+  # Default RabbitMQ delivery acknowledgement timeout is 30 minutes
+  consumer_timeout = 900000
+  
+  DATA
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  apply_immediately  = true
+  engine_type        = "RabbitMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t3.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  configuration {
+    id = aws_mq_configuration.%[3]s.id
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, configurationName)
+}
+
 func testAccBrokerConfig_rabbitLogs(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_mq_broker" "test" {
@@ -2202,6 +5667,108 @@ resource "aws_mq_broker" "test" {
 `, rName, version, ldapUsername)
 }
 
+func testAccBrokerConfig_auditLog(rName, version string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately  = true
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  logs {
+    general = true
+    audit   = %[3]t
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, enabled)
+}
+
+func testAccBrokerConfig_authenticationStrategy(rName, version, authenticationStrategy string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately       = true
+  authentication_strategy = %[3]q
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  security_groups         = [aws_security_group.test.id]
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+
+  ldap_server_metadata {
+    hosts                    = ["my.ldap.server-1.com", "my.ldap.server-2.com"]
+    role_base                = "role.base"
+    role_name                = "role.name"
+    role_search_matching     = "role.search.matching"
+    role_search_subtree      = true
+    service_account_password = "supersecret"
+    service_account_username = "anyusername"
+    user_base                = "user.base"
+    user_role_name           = "user.role.name"
+    user_search_matching     = "user.search.matching"
+    user_search_subtree      = true
+  }
+}
+`, rName, version, authenticationStrategy)
+}
+
+func testAccBrokerConfig_authenticationStrategyLDAPNoMetadata(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  authentication_strategy = "ldap"
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  security_groups         = [aws_security_group.test.id]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
 func testAccBrokerConfig_instanceType(rName, version, instanceType string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -2231,3 +5798,61 @@ resource "aws_mq_broker" "test" {
 }
 `, rName, version, instanceType)
 }
+
+func testAccBrokerConfig_storageType(rName, version, storageType string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  storage_type       = %[3]q
+  host_instance_type = "mq.m5.large"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, storageType)
+}
+
+func testAccBrokerConfig_waitForSteadyState(rName, version, dayOfWeek string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name           = %[1]q
+  engine_type           = "ActiveMQ"
+  engine_version        = %[2]q
+  host_instance_type    = "mq.t2.micro"
+  security_groups       = [aws_security_group.test.id]
+  wait_for_steady_state = true
+
+  maintenance_window_start_time {
+    day_of_week = %[3]q
+    time_of_day = "02:00"
+    time_zone   = "UTC"
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, dayOfWeek)
+}
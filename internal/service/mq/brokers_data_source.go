@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_mq_brokers", name="Brokers")
+func dataSourceBrokers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBrokersRead,
+
+		Schema: map[string]*schema.Schema{
+			"broker_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"broker_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"broker_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"engine_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.ValidateIgnoreCase[types.EngineType](),
+			},
+		},
+	}
+}
+
+func dataSourceBrokersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+
+	engineType := d.Get("engine_type").(string)
+	brokers, err := findBrokers(ctx, conn, &mq.ListBrokersInput{}, func(v *types.BrokerSummary) bool {
+		return engineType == "" || strings.EqualFold(string(v.EngineType), engineType)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Brokers: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("broker_summaries", flattenBrokerSummaries(brokers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting broker_summaries: %s", err)
+	}
+
+	return diags
+}
+
+func flattenBrokerSummaries(apiObjects []types.BrokerSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"broker_id":       aws.ToString(apiObject.BrokerId),
+			"broker_name":     aws.ToString(apiObject.BrokerName),
+			"broker_state":    apiObject.BrokerState,
+			"deployment_mode": apiObject.DeploymentMode,
+			"engine_type":     apiObject.EngineType,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMQBrokersDataSource_engineType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_mq_brokers.test"
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokersDataSourceConfig_engineType(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "broker_summaries.*.broker_id", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_summaries.*", map[string]string{
+						"broker_name":     rName,
+						"broker_state":    "RUNNING",
+						"deployment_mode": "SINGLE_INSTANCE",
+						"engine_type":     "ACTIVEMQ",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBrokersDataSourceConfig_engineType(rName string) string {
+	return acctest.ConfigCompose(testAccBrokerConfig_basic(rName, testAccBrokerVersionNewer), `
+data "aws_mq_brokers" "test" {
+  engine_type = "ActiveMQ"
+
+  depends_on = [aws_mq_broker.test]
+}
+`)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_mq_configuration", name="Configuration")
+func dataSourceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"latest_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configurationID := d.Get("id").(string)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		configuration, err := findConfiguration(ctx, conn, &mq.ListConfigurationsInput{}, func(v *types.Configuration) bool {
+			return aws.ToString(v.Name) == name
+		})
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("MQ Configuration", err))
+		}
+
+		configurationID = aws.ToString(configuration.Id)
+	}
+
+	configuration, err := findConfigurationByID(ctx, conn, configurationID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Configuration (%s): %s", configurationID, err)
+	}
+
+	if configuration.LatestRevision == nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Configuration (%s): no latest revision", configurationID)
+	}
+
+	d.SetId(aws.ToString(configuration.Id))
+	d.Set("arn", configuration.Arn)
+	d.Set("authentication_strategy", configuration.AuthenticationStrategy)
+	d.Set("description", configuration.LatestRevision.Description)
+	d.Set("engine_type", configuration.EngineType)
+	d.Set("engine_version", configuration.EngineVersion)
+	d.Set("latest_revision", configuration.LatestRevision.Revision)
+	d.Set("name", configuration.Name)
+
+	revision := strconv.FormatInt(int64(aws.ToInt32(configuration.LatestRevision.Revision)), 10)
+	configurationRevision, err := conn.DescribeConfigurationRevision(ctx, &mq.DescribeConfigurationRevisionInput{
+		ConfigurationId:       aws.String(d.Id()),
+		ConfigurationRevision: aws.String(revision),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Configuration (%s) revision (%s): %s", d.Id(), revision, err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(aws.ToString(configurationRevision.Data))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "base64 decoding: %s", err)
+	}
+
+	d.Set("data", string(data))
+
+	if err := d.Set("tags", KeyValueTags(ctx, configuration.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func findConfiguration(ctx context.Context, conn *mq.Client, input *mq.ListConfigurationsInput, filter tfslices.Predicate[*types.Configuration]) (*types.Configuration, error) {
+	output, err := findConfigurations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findConfigurations(ctx context.Context, conn *mq.Client, input *mq.ListConfigurationsInput, filter tfslices.Predicate[*types.Configuration]) ([]types.Configuration, error) {
+	var output []types.Configuration
+
+	for {
+		page, err := conn.ListConfigurations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Configurations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if page.NextToken == nil {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
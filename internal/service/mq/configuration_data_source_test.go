@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMQConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_configuration.test"
+	dataSourceByIdName := "data.aws_mq_configuration.by_id"
+	dataSourceByNameName := "data.aws_mq_configuration.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MQEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationDataSourceConfig_basic(rName, "TfAccTest MQ Configuration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceByIdName, "latest_revision", "1"),
+					resource.TestCheckResourceAttr(dataSourceByNameName, "latest_revision", "1"),
+				),
+			},
+			{
+				Config: testAccConfigurationDataSourceConfig_basic(rName, "TfAccTest MQ Configuration Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "authentication_strategy", resourceName, "authentication_strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "data", resourceName, "data"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "engine_type", resourceName, "engine_type"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "engine_version", resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "latest_revision", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "latest_revision", resourceName, "latest_revision"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceByNameName, "latest_revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationDataSourceConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "test" {
+  description    = %[2]q
+  name           = %[1]q
+  engine_type    = "ActiveMQ"
+  engine_version = "5.17.6"
+
+  data = <<DATA
+<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>
+DATA
+}
+
+data "aws_mq_configuration" "by_id" {
+  id = aws_mq_configuration.test.id
+
+  depends_on = [aws_mq_configuration.test]
+}
+
+data "aws_mq_configuration" "by_name" {
+  name = aws_mq_configuration.test.name
+
+  depends_on = [aws_mq_configuration.test]
+}
+`, rName, description)
+}
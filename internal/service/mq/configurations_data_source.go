@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_mq_configurations", name="Configurations")
+func dataSourceConfigurations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"engine_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.ValidateIgnoreCase[types.EngineType](),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MQClient(ctx)
+
+	engineType := d.Get("engine_type").(string)
+	engineVersion := d.Get("engine_version").(string)
+	configurations, err := findConfigurations(ctx, conn, &mq.ListConfigurationsInput{}, func(v *types.Configuration) bool {
+		if engineType != "" && !strings.EqualFold(string(v.EngineType), engineType) {
+			return false
+		}
+
+		if engineVersion != "" && aws.ToString(v.EngineVersion) != engineVersion {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MQ Configurations: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("configurations", flattenConfigurations(configurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configurations: %s", err)
+	}
+
+	return diags
+}
+
+func flattenConfigurations(apiObjects []types.Configuration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"id":   aws.ToString(apiObject.Id),
+			"name": aws.ToString(apiObject.Name),
+		}
+
+		if v := apiObject.LatestRevision; v != nil {
+			tfMap["description"] = aws.ToString(v.Description)
+			tfMap["latest_revision"] = aws.ToInt32(v.Revision)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMQConfigurationsDataSource_engineType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_mq_configurations.test"
+	activeMQResourceName := "aws_mq_configuration.activemq"
+	rabbitMQResourceName := "aws_mq_configuration.rabbitmq"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationsDataSourceConfig_engineType(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "configurations.*.id", activeMQResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"description":     "TfAccTest MQ Configuration",
+						"latest_revision": "1",
+						"name":            rName + "-activemq",
+					}),
+					testAccCheckConfigurationsNotContains(dataSourceName, rabbitMQResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationsNotContains(dataSourceName, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		for k, v := range ds.Primary.Attributes {
+			if v == rs.Primary.ID {
+				return fmt.Errorf("%s contains %s (%s) at %s", dataSourceName, resourceName, v, k)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigurationsDataSourceConfig_engineType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "activemq" {
+  description    = "TfAccTest MQ Configuration"
+  name           = "%[1]s-activemq"
+  engine_type    = "ActiveMQ"
+  engine_version = "5.17.6"
+
+  data = <<DATA
+<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<broker xmlns="http://activemq.apache.org/schema/core">
+</broker>
+DATA
+}
+
+resource "aws_mq_configuration" "rabbitmq" {
+  description    = "TfAccTest MQ Configuration"
+  name           = "%[1]s-rabbitmq"
+  engine_type    = "RabbitMQ"
+  engine_version = "3.11.16"
+
+  data = <<DATA
+consumer_timeout = 60000
+DATA
+}
+
+data "aws_mq_configurations" "test" {
+  engine_type = "ActiveMQ"
+
+  depends_on = [aws_mq_configuration.activemq, aws_mq_configuration.rabbitmq]
+}
+`, rName)
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+// Error code constants missing from AWS Go SDK operation models.
+const (
+	errCodeConflictException = "ConflictException"
+)
@@ -8,6 +8,45 @@ var (
 	ResourceBroker        = resourceBroker
 	ResourceConfiguration = resourceConfiguration
 
-	FindBrokerByID        = findBrokerByID
-	FindConfigurationByID = findConfigurationByID
+	BrokerRebootWarning                    = brokerRebootWarning
+	BrokerUpdatePending                    = brokerUpdatePending
+	CreateBrokerConfiguration              = createBrokerConfiguration
+	ConfigurationDrift                     = configurationDrift
+	CustomizeDiffRabbitMQUsers             = customizeDiffRabbitMQUsers
+	CustomizeDiffReplicationUser           = customizeDiffReplicationUser
+	CustomizeDiffSecurityGroups            = customizeDiffSecurityGroups
+	DeleteBroker                           = deleteBroker
+	DescribeUserConcurrency                = describeUserConcurrency
+	EngineVersionMajorMinorMatches         = engineVersionMajorMinorMatches
+	EngineVersionPatchUpgraded             = engineVersionPatchUpgraded
+	ExpandLogs                             = expandLogs
+	ExpandUpdateBrokerConfigurationInput   = expandUpdateBrokerConfigurationInput
+	ExpandUsers                            = expandUsers
+	ExpandUsersForBroker                   = expandUsersForBroker
+	FindBrokerByID                         = findBrokerByID
+	FindBrokerEngineVersions               = findBrokerEngineVersions
+	FindBrokerUsers                        = findBrokerUsers
+	FindConfigurationByID                  = findConfigurationByID
+	FlattenBrokerInstances                 = flattenBrokerInstances
+	FlattenClusterMemberCount              = flattenClusterMemberCount
+	FlattenEndpointsByProtocol             = flattenEndpointsByProtocol
+	FlattenLogs                            = flattenLogs
+	FlattenUsers                           = flattenUsers
+	InstanceAvailabilityZonesFromState     = instanceAvailabilityZonesFromState
+	ReadBroker                             = readBroker
+	SecurityGroupsWithEmptySubnetIDs       = securityGroupsWithEmptySubnetIDs
+	SuppressEngineVersionUpgraded          = suppressEngineVersionUpgraded
+	SuppressLDAPServiceAccountPassword     = suppressLDAPServiceAccountPassword
+	SuppressLatestConfigurationRevision    = suppressLatestConfigurationRevision
+	UpdateBroker                           = updateBroker
+	ValidateBrokerDeploymentModeTransition = validateBrokerDeploymentModeTransition
+	ValidateBrokerEngineVersion            = validateBrokerEngineVersion
+	ValidateBrokerHostInstanceType         = validateBrokerHostInstanceType
+	ValidateBrokerLDAPHost                 = validateBrokerLDAPHost
+	ValidateBrokerReplicationUsers         = validateBrokerReplicationUsers
+	ValidateBrokerStorageType              = validateBrokerStorageType
+	ValidateBrokerSubnetIDs                = validateBrokerSubnetIDs
+	WaitBrokerCreated                      = waitBrokerCreated
+	WaitBrokerRebooted                     = waitBrokerRebooted
+	WaitBrokerUpdated                      = waitBrokerUpdated
 )
@@ -39,6 +39,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_mq_broker_instance_type_offerings",
 			Name:     "Broker Instance Type Offerings",
 		},
+		{
+			Factory:  dataSourceBrokers,
+			TypeName: "aws_mq_brokers",
+			Name:     "Brokers",
+		},
+		{
+			Factory:  dataSourceConfiguration,
+			TypeName: "aws_mq_configuration",
+			Name:     "Configuration",
+		},
+		{
+			Factory:  dataSourceConfigurations,
+			TypeName: "aws_mq_configurations",
+			Name:     "Configurations",
+		},
 	}
 }
 
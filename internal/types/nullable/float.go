@@ -32,6 +32,10 @@ func (i Float) Value() (float64, bool, error) {
 	return value, false, nil
 }
 
+func NewFloat(v float64) Float {
+	return Float(strconv.FormatFloat(v, 'g', -1, 64))
+}
+
 // ValidateTypeStringNullableFloat provides custom error messaging for TypeString floats
 // Some arguments require an float value or unspecified, empty field.
 func ValidateTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
@@ -51,3 +55,15 @@ func ValidateTypeStringNullableFloat(v interface{}, k string) (ws []string, es [
 
 	return
 }
+
+func DiffSuppressNullableFloat(k, o, n string, d *schema.ResourceData) bool {
+	ov, onull, _ := Float(o).Value()
+	nv, nnull, _ := Float(n).Value()
+	if onull && nnull {
+		return true
+	}
+	if !onull && !nnull {
+		return ov == nv
+	}
+	return false
+}
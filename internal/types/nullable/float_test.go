@@ -101,3 +101,90 @@ func TestValidationFloat(t *testing.T) {
 		},
 	})
 }
+
+func TestNewFloat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		val      float64
+		expected Float
+	}{
+		{
+			val:      0,
+			expected: "0",
+		},
+		{
+			val:      1.5,
+			expected: "1.5",
+		},
+		{
+			val:      -0.25,
+			expected: "-0.25",
+		},
+	}
+
+	for i, tc := range cases {
+		v := NewFloat(tc.val)
+
+		if v != tc.expected {
+			t.Fatalf("expected test case %d to be %q, got %q", i, tc.expected, v)
+		}
+		if v.IsNull() {
+			t.Fatalf("expected test case %d not to be null", i)
+		}
+	}
+}
+
+func TestDiffSuppressFloat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{
+			old:        "",
+			new:        "",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "0",
+			equivalent: true,
+		},
+		{
+			old:        "1.5",
+			new:        "1.50",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "1.5",
+			equivalent: false,
+		},
+
+		// Zero is not null
+		{
+			old:        "",
+			new:        "0",
+			equivalent: false,
+		},
+		{
+			old:        "0",
+			new:        "",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range cases {
+		v := DiffSuppressNullableFloat("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !v {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && v {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
@@ -32,6 +32,10 @@ func (i Int) Value() (int64, bool, error) {
 	return value, false, nil
 }
 
+func NewInt(v int64) Int {
+	return Int(strconv.FormatInt(v, 10))
+}
+
 // ValidateTypeStringNullableInt provides custom error messaging for TypeString ints
 // Some arguments require an int value or unspecified, empty field.
 func ValidateTypeStringNullableInt(v interface{}, k string) (ws []string, es []error) {
@@ -107,3 +111,15 @@ func ValidateTypeStringNullableIntBetween(min int, max int) schema.SchemaValidat
 		return
 	}
 }
+
+func DiffSuppressNullableInt(k, o, n string, d *schema.ResourceData) bool {
+	ov, onull, _ := Int(o).Value()
+	nv, nnull, _ := Int(n).Value()
+	if onull && nnull {
+		return true
+	}
+	if !onull && !nnull {
+		return ov == nv
+	}
+	return false
+}
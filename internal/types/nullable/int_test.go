@@ -25,6 +25,11 @@ func TestNullableInt(t *testing.T) {
 			expectNull:    false,
 			expectedValue: 1,
 		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0,
+		},
 		{
 			val:           "",
 			expectNull:    true,
@@ -108,3 +113,90 @@ func TestValidationIntAtLeast(t *testing.T) {
 		},
 	})
 }
+
+func TestNewInt(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		val      int64
+		expected Int
+	}{
+		{
+			val:      0,
+			expected: "0",
+		},
+		{
+			val:      42,
+			expected: "42",
+		},
+		{
+			val:      -1,
+			expected: "-1",
+		},
+	}
+
+	for i, tc := range cases {
+		v := NewInt(tc.val)
+
+		if v != tc.expected {
+			t.Fatalf("expected test case %d to be %q, got %q", i, tc.expected, v)
+		}
+		if v.IsNull() {
+			t.Fatalf("expected test case %d not to be null", i)
+		}
+	}
+}
+
+func TestDiffSuppressInt(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{
+			old:        "",
+			new:        "",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "0",
+			equivalent: true,
+		},
+		{
+			old:        "42",
+			new:        "+42",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "42",
+			equivalent: false,
+		},
+
+		// Zero is not null
+		{
+			old:        "",
+			new:        "0",
+			equivalent: false,
+		},
+		{
+			old:        "0",
+			new:        "",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range cases {
+		v := DiffSuppressNullableInt("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !v {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && v {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}